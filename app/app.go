@@ -31,6 +31,10 @@ import (
 	paramsClient "github.com/maticnetwork/heimdall/params/client"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	"github.com/maticnetwork/heimdall/sidechannel"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/slashing"
+	slashingTypes "github.com/maticnetwork/heimdall/slashing/types"
 	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	"github.com/maticnetwork/heimdall/supply"
@@ -65,6 +69,8 @@ var (
 		bor.AppModuleBasic{},
 		clerk.AppModuleBasic{},
 		topup.AppModuleBasic{},
+		sidechannel.AppModuleBasic{},
+		slashing.AppModuleBasic{},
 		gov.NewAppModuleBasic(paramsClient.ProposalHandler),
 	)
 
@@ -97,6 +103,8 @@ type HeimdallApp struct {
 	BorKeeper        bor.Keeper
 	ClerkKeeper      clerk.Keeper
 	TopupKeeper      topup.Keeper
+	SideTxKeeper     sidechannel.Keeper
+	SlashingKeeper   slashing.Keeper
 	// param keeper
 	ParamsKeeper params.Keeper
 
@@ -156,6 +164,11 @@ func (d ModuleCommunicator) SendCoins(ctx sdk.Context, fromAddr types.HeimdallAd
 	return d.App.BankKeeper.SendCoins(ctx, fromAddr, toAddr, amt)
 }
 
+// RemovePendingSideTxs removes pending side txs of given msg route and type
+func (d ModuleCommunicator) RemovePendingSideTxs(ctx sdk.Context, route string, msgType string) {
+	d.App.SideTxKeeper.RemovePendingSideTxs(ctx, route, msgType)
+}
+
 //
 // Heimdall app
 //
@@ -189,9 +202,11 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.StoreKey,
 		clerkTypes.StoreKey,
 		topupTypes.StoreKey,
+		sidechannelTypes.StoreKey,
+		slashingTypes.StoreKey,
 		paramsTypes.StoreKey,
 	)
-	tkeys := sdk.NewTransientStoreKeys(paramsTypes.TStoreKey)
+	tkeys := sdk.NewTransientStoreKeys(paramsTypes.TStoreKey, slashingTypes.TStoreKey)
 
 	// create heimdall app
 	var app = &HeimdallApp{
@@ -213,6 +228,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 	app.subspaces[borTypes.ModuleName] = app.ParamsKeeper.Subspace(borTypes.DefaultParamspace)
 	app.subspaces[clerkTypes.ModuleName] = app.ParamsKeeper.Subspace(clerkTypes.DefaultParamspace)
 	app.subspaces[topupTypes.ModuleName] = app.ParamsKeeper.Subspace(topupTypes.DefaultParamspace)
+	app.subspaces[slashingTypes.ModuleName] = app.ParamsKeeper.Subspace(slashingTypes.DefaultParamspace)
 	//
 	// Contract caller
 	//
@@ -292,6 +308,7 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.subspaces[checkpointTypes.ModuleName],
 		common.DefaultCodespace,
 		app.StakingKeeper,
+		moduleCommunicator,
 	)
 
 	app.BorKeeper = bor.NewKeeper(
@@ -320,6 +337,22 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		app.StakingKeeper,
 	)
 
+	app.SideTxKeeper = sidechannel.NewKeeper(
+		app.cdc,
+		keys[sidechannelTypes.StoreKey], // target store
+		common.DefaultCodespace,
+		app.StakingKeeper,
+	)
+
+	app.SlashingKeeper = slashing.NewKeeper(
+		app.cdc,
+		keys[slashingTypes.StoreKey],   // target store
+		tkeys[slashingTypes.TStoreKey], // jailed in block flag
+		app.subspaces[slashingTypes.ModuleName],
+		slashingTypes.DefaultCodespace,
+		app.StakingKeeper,
+	)
+
 	// NOTE: Any module instantiated in the module manager that is later modified
 	// must be passed by reference here.
 	app.mm = module.NewManager(
@@ -334,6 +367,8 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		bor.NewAppModule(app.BorKeeper, &app.caller),
 		clerk.NewAppModule(app.ClerkKeeper, &app.caller),
 		topup.NewAppModule(app.TopupKeeper, &app.caller),
+		sidechannel.NewAppModule(app.SideTxKeeper),
+		slashing.NewAppModule(app.SlashingKeeper),
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -348,10 +383,13 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		borTypes.ModuleName,
 		clerkTypes.ModuleName,
 		topupTypes.ModuleName,
+		sidechannelTypes.ModuleName,
+		slashingTypes.ModuleName,
 	)
 
 	// register message routes and query routes
-	app.mm.RegisterRoutes(app.Router(), app.QueryRouter())
+	// side tx msgs of all modules are routed to sidechannel to await validator votes
+	app.mm.RegisterRoutes(sidechannel.NewRouter(app.Router(), app.SideTxKeeper), app.QueryRouter())
 
 	// register message routes
 	// app.Router().
@@ -382,7 +420,6 @@ func NewHeimdallApp(logger log.Logger, db dbm.DB, baseAppOptions ...func(*bam.Ba
 		auth.NewAnteHandler(
 			app.AccountKeeper,
 			app.SupplyKeeper,
-			&app.caller,
 			auth.DefaultSigVerificationGasConsumer,
 		),
 	)
@@ -496,8 +533,14 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 		app.AccountKeeper.RemoveBlockProposer(ctx)
 	}
 
+	// end block, module end blockers (eg. sidechannel applying staking side txs) can change validators
+	app.mm.EndBlock(ctx, req)
+
 	var tmValUpdates []abci.ValidatorUpdate
-	if ctx.BlockHeader().NumTxs > 0 {
+	// validators changed by txs, end blockers or slashing in begin block
+	if app.StakingKeeper.HasValidatorSetChanged(ctx) {
+		app.StakingKeeper.SetValidatorSetChangedFlag(ctx, false)
+
 		// --- Start update to new validators
 		currentValidatorSet := app.StakingKeeper.GetValidatorSet(ctx)
 		allValidators := app.StakingKeeper.GetAllValidators(ctx)
@@ -531,6 +574,9 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 				return abci.ResponseEndBlock{}
 			}
 
+			// keep history of validator set
+			app.StakingKeeper.SetValidatorSetSnapshot(ctx)
+
 			// convert updates from map to array
 			for _, v := range setUpdates {
 				tmValUpdates = append(tmValUpdates, abci.ValidatorUpdate{
@@ -541,9 +587,6 @@ func (app *HeimdallApp) EndBlocker(ctx sdk.Context, req abci.RequestEndBlock) ab
 		}
 	}
 
-	// end block
-	app.mm.EndBlock(ctx, req)
-
 	// send validator updates to peppermint
 	return abci.ResponseEndBlock{
 		ValidatorUpdates: tmValUpdates,
@@ -0,0 +1,153 @@
+package cmd
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/spf13/cobra"
+
+	"github.com/maticnetwork/heimdall/bridge/pier"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	allFlag = "all"
+)
+
+// dlqCmd represents the dead-letter store command
+var dlqCmd = &cobra.Command{
+	Use:   "dlq",
+	Short: "Inspect and replay failed bridge messages (bridge must be stopped)",
+}
+
+// dlqListCmd lists all dead letters
+var dlqListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List failed bridge messages",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := pier.OpenDeadLetterStore()
+		if err != nil {
+			return err
+		}
+		defer pier.CloseBridgeDBInstance()
+
+		deadLetters, err := store.List()
+		if err != nil {
+			return err
+		}
+
+		for _, deadLetter := range deadLetters {
+			fmt.Println(deadLetter.String())
+		}
+		fmt.Printf("Total failed messages: %v\n", len(deadLetters))
+		return nil
+	},
+}
+
+// dlqReplayCmd publishes dead letters back to their routes
+var dlqReplayCmd = &cobra.Command{
+	Use:   "replay [id...]",
+	Short: "Publish failed bridge messages back to queue",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		replayAll, _ := cmd.Flags().GetBool(allFlag)
+		if len(args) == 0 && !replayAll {
+			return fmt.Errorf("Provide message ids to replay or use --%v", allFlag)
+		}
+
+		store, err := pier.OpenDeadLetterStore()
+		if err != nil {
+			return err
+		}
+		defer pier.CloseBridgeDBInstance()
+
+		ids, err := dlqIDs(store, args, replayAll)
+		if err != nil {
+			return err
+		}
+
+		// queue backend
+		queue, err := pier.NewQueue(helper.GetConfig())
+		if err != nil {
+			return err
+		}
+		if err := queue.Start(); err != nil {
+			return err
+		}
+		defer queue.Close()
+
+		for _, id := range ids {
+			if err := store.Replay(queue, id); err != nil {
+				return err
+			}
+			fmt.Printf("Replayed message %v\n", id)
+		}
+		return nil
+	},
+}
+
+// dlqPurgeCmd deletes dead letters
+var dlqPurgeCmd = &cobra.Command{
+	Use:   "purge [id...]",
+	Short: "Delete failed bridge messages (all messages if no id is provided)",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		store, err := pier.OpenDeadLetterStore()
+		if err != nil {
+			return err
+		}
+		defer pier.CloseBridgeDBInstance()
+
+		if len(args) == 0 {
+			count, err := store.Purge()
+			if err != nil {
+				return err
+			}
+			fmt.Printf("Purged %v messages\n", count)
+			return nil
+		}
+
+		ids, err := dlqIDs(store, args, false)
+		if err != nil {
+			return err
+		}
+
+		for _, id := range ids {
+			if err := store.Remove(id); err != nil {
+				return err
+			}
+			fmt.Printf("Purged message %v\n", id)
+		}
+		return nil
+	},
+}
+
+// dlqIDs parses ids from args or returns ids of all dead letters
+func dlqIDs(store *pier.DeadLetterStore, args []string, all bool) ([]uint64, error) {
+	var ids []uint64
+	if all {
+		deadLetters, err := store.List()
+		if err != nil {
+			return nil, err
+		}
+		for _, deadLetter := range deadLetters {
+			ids = append(ids, deadLetter.ID)
+		}
+		return ids, nil
+	}
+
+	for _, arg := range args {
+		id, err := strconv.ParseUint(arg, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid message id: %v", arg)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func init() {
+	dlqReplayCmd.Flags().Bool(allFlag, false, "replay all failed messages")
+
+	dlqCmd.AddCommand(dlqListCmd, dlqReplayCmd, dlqPurgeCmd)
+	rootCmd.AddCommand(dlqCmd)
+}
@@ -0,0 +1,69 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/app"
+	"github.com/maticnetwork/heimdall/bridge/pier"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+const (
+	fromBlockFlag = "from-block"
+	toBlockFlag   = "to-block"
+	eventsFlag    = "events"
+)
+
+// resyncCmd represents the resync command
+var resyncCmd = &cobra.Command{
+	Use:   "resync",
+	Short: "Re-process mainchain events of a block range without moving bridge last block",
+	Long: `Re-process mainchain events of a block range without moving bridge last block.
+Events already recorded on heimdall are skipped. Messages are published on bridge queue,
+and broadcast by bridge consumer (for leveldb queue backend, on next bridge start).`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		fromBlock, _ := cmd.Flags().GetUint64(fromBlockFlag)
+		toBlock, _ := cmd.Flags().GetUint64(toBlockFlag)
+		events, _ := cmd.Flags().GetStringSlice(eventsFlag)
+
+		if toBlock == 0 {
+			return fmt.Errorf("--%v is required", toBlockFlag)
+		}
+
+		// create codec
+		cdc := app.MakeCodec()
+		app.MakePulp()
+
+		// queue backend
+		queue, err := pier.NewQueue(helper.GetConfig())
+		if err != nil {
+			return err
+		}
+		if err := queue.Start(); err != nil {
+			return err
+		}
+		defer pier.CloseBridgeDBInstance()
+		defer queue.Close()
+
+		// queue connector is used only for publishing
+		queueConnector := pier.NewQueueConnector(cdc, queue)
+		syncer := pier.NewSyncer(cdc, queueConnector, httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket"))
+
+		return syncer.Resync(fromBlock, toBlock, events)
+	},
+}
+
+func init() {
+	resyncCmd.Flags().Uint64(fromBlockFlag, 0, "mainchain block to start resync from")
+	resyncCmd.Flags().Uint64(toBlockFlag, 0, "mainchain block to resync to (inclusive)")
+	resyncCmd.Flags().Bool(pier.DryRunFlag, false, "log msgs which would be published, without publishing them")
+	viper.BindPFlag(pier.DryRunFlag, resyncCmd.Flags().Lookup(pier.DryRunFlag))
+	resyncCmd.Flags().StringSlice(eventsFlag, []string{}, "comma separated events to resync (eg. StateSynced,StakeUpdate), all events by default")
+
+	rootCmd.AddCommand(resyncCmd)
+}
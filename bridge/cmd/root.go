@@ -9,6 +9,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/maticnetwork/heimdall/bridge/pier"
 	"github.com/maticnetwork/heimdall/helper"
 )
 
@@ -47,6 +48,9 @@ func InitTendermintViperConfig(cmd *cobra.Command) {
 	viper.Set(bridgeDBFlag, bridgeDBValue)
 	viper.Set(borChainIDFlag, borChainIDValue)
 
+	// record latency and errors of mainchain and bor rpc requests
+	helper.SetRPCTransport(pier.NewMetricsTransport(nil))
+
 	// start heimdall config
 	helper.InitHeimdallConfig("")
 }
@@ -2,9 +2,10 @@ package cmd
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
-	"sync"
+	"syscall"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,6 +24,8 @@ import (
 
 const (
 	WaitDuration = 1 * time.Minute
+
+	metricsAddrFlag = "metrics-addr"
 )
 
 // GetStartCmd returns the start command to start bridge
@@ -36,8 +39,16 @@ func GetStartCmd() *cobra.Command {
 			// create codec
 			cdc := app.MakeCodec()
 			app.MakePulp()
+			// queue backend
+			_queue, err := pier.NewQueue(helper.GetConfig())
+			if err != nil {
+				panic(fmt.Sprintf("Error creating queue %v", err))
+			}
+
+			defer _queue.Close()
+
 			// queue connector & http client
-			_queueConnector := pier.NewQueueConnector(cdc, helper.GetConfig().AmqpURL)
+			_queueConnector := pier.NewQueueConnector(cdc, _queue)
 			_httpClient := httpClient.NewHTTP(helper.GetConfig().TendermintRPCUrl, "/websocket")
 
 			// selected services to start
@@ -46,37 +57,33 @@ func GetStartCmd() *cobra.Command {
 				panic(fmt.Sprintf("No services selected to start. select services using --all or --only flag"))
 			}
 
-			// sync group
-			var wg sync.WaitGroup
-
-			// go routine to catch signal
-			catchSignal := make(chan os.Signal, 1)
-			signal.Notify(catchSignal, os.Interrupt)
-			go func() {
-				// sig is a ^C, handle it
-				for range catchSignal {
-					// stop processes
-					for _, service := range services {
-						service.Stop()
+			// metrics & health server
+			if metricsAddr := viper.GetString(metricsAddrFlag); metricsAddr != "" {
+				go func() {
+					logger.Info("Starting metrics server", "addr", metricsAddr)
+					if err := http.ListenAndServe(metricsAddr, pier.NewMetricsHandler(_queue, services)); err != nil {
+						logger.Error("Error while running metrics server", "error", err)
 					}
+				}()
+			}
 
-					// stop http client
-					_httpClient.Stop()
-
-					// exit
-					os.Exit(1)
-				}
-			}()
+			// catch interrupt and terminate signals
+			catchSignal := make(chan os.Signal, 1)
+			signal.Notify(catchSignal, os.Interrupt, syscall.SIGTERM)
 
 			// Start http client
-			err := _httpClient.Start()
+			err = _httpClient.Start()
 			if err != nil {
 				panic(fmt.Sprintf("Error connecting to server %v", err))
 			}
 
+			// close http client and bridge db after all services (and queue) are stopped
+			defer pier.CloseBridgeDBInstance()
+			defer _httpClient.Stop()
+
 			// cli context
 			cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
-			cliCtx.BroadcastMode = client.BroadcastAsync
+			cliCtx.BroadcastMode = client.BroadcastSync
 			cliCtx.TrustNode = true
 
 			// start bridge services only when node fully synced
@@ -87,27 +94,35 @@ func GetStartCmd() *cobra.Command {
 				} else {
 					logger.Info("Waiting for heimdall to be synced")
 				}
-				time.Sleep(WaitDuration)
-			}
 
-			// strt all processes
-			for _, service := range services {
-				go func(serv common.Service) {
-					defer wg.Done()
-					// TODO handle error while starting service
-					serv.Start()
-					<-serv.Quit()
-				}(service)
+				select {
+				case <-time.After(WaitDuration):
+				case sig := <-catchSignal:
+					logger.Info("Received signal, exiting", "signal", sig)
+					return
+				}
 			}
-			// wait for all processes
-			wg.Add(len(services))
-			wg.Wait()
+
+			// start all processes, supervisor restarts stopped ones
+			supervisor := NewSupervisor(services, logger)
+			supervisor.Start()
+
+			// wait for signal, then stop services letting in-flight work drain
+			sig := <-catchSignal
+			logger.Info("Received signal, stopping bridge services", "signal", sig)
+			supervisor.Stop()
 		}}
 	startCmd.Flags().Bool("all", false, "start all bridge services")
 	viper.BindPFlag("all", startCmd.Flags().Lookup("all"))
 
 	startCmd.Flags().StringSlice("only", []string{}, "comma separated bridge services to start")
 	viper.BindPFlag("only", startCmd.Flags().Lookup("only"))
+
+	startCmd.Flags().Bool(pier.DryRunFlag, false, "run detection logic and log msgs/txs which would be sent, without publishing or sending anything")
+	viper.BindPFlag(pier.DryRunFlag, startCmd.Flags().Lookup(pier.DryRunFlag))
+
+	startCmd.Flags().String(metricsAddrFlag, "", "address to serve /metrics and /health on (eg. :2112), disabled if empty")
+	viper.BindPFlag(metricsAddrFlag, startCmd.Flags().Lookup(metricsAddrFlag))
 	return startCmd
 }
 
@@ -127,6 +142,7 @@ func SelectedServices(cdc *codec.Codec, _httpClient *httpClient.HTTP, _queueConn
 			pier.NewAckService(cdc, _queueConnector, _httpClient),
 			pier.NewSpanService(cdc, _queueConnector, _httpClient),
 			pier.NewClerkService(cdc, _queueConnector, _httpClient),
+			pier.NewSideTxService(cdc, _queueConnector, _httpClient),
 		)
 	} else {
 		for _, service := range onlyServices {
@@ -141,6 +157,8 @@ func SelectedServices(cdc *codec.Codec, _httpClient *httpClient.HTTP, _queueConn
 				services = append(services, pier.NewSpanService(cdc, _queueConnector, _httpClient))
 			case "clerk":
 				services = append(services, pier.NewClerkService(cdc, _queueConnector, _httpClient))
+			case "sidetx":
+				services = append(services, pier.NewSideTxService(cdc, _queueConnector, _httpClient))
 			}
 		}
 	}
@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"sync"
+	"time"
+
+	"github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// delay before first restart of stopped service
+	supervisorInitialBackoff = 1 * time.Second
+	// max delay between restarts
+	supervisorMaxBackoff = 1 * time.Minute
+	// service which ran longer than this before stopping is restarted with initial backoff
+	supervisorStablePeriod = 5 * time.Minute
+)
+
+// Supervisor starts bridge services and restarts them with exponential backoff
+// whenever they stop (or fail to start) before supervisor is stopped.
+type Supervisor struct {
+	services []common.Service
+
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+
+	// closed on stop
+	quit chan struct{}
+	wg   sync.WaitGroup
+
+	logger log.Logger
+}
+
+// NewSupervisor creates supervisor for given services
+func NewSupervisor(services []common.Service, logger log.Logger) *Supervisor {
+	return &Supervisor{
+		services:       services,
+		initialBackoff: supervisorInitialBackoff,
+		maxBackoff:     supervisorMaxBackoff,
+		quit:           make(chan struct{}),
+		logger:         logger,
+	}
+}
+
+// Start starts all services
+func (s *Supervisor) Start() {
+	for _, service := range s.services {
+		s.wg.Add(1)
+		go s.supervise(service)
+	}
+}
+
+// Stop stops restarting services, and then stops all running services in reverse order,
+// so consumer (started first) drains messages published by other services last.
+func (s *Supervisor) Stop() {
+	close(s.quit)
+	s.wg.Wait()
+
+	for i := len(s.services) - 1; i >= 0; i-- {
+		if s.services[i].IsRunning() {
+			s.services[i].Stop()
+		}
+	}
+}
+
+// supervise runs service until supervisor is stopped
+func (s *Supervisor) supervise(service common.Service) {
+	defer s.wg.Done()
+
+	backoff := s.initialBackoff
+	for {
+		startedAt := time.Now()
+		if err := service.Start(); err != nil {
+			s.logger.Error("Error while starting service", "service", service.String(), "error", err)
+		} else {
+			select {
+			case <-service.Quit():
+			case <-s.quit:
+				return
+			}
+
+			if time.Since(startedAt) >= supervisorStablePeriod {
+				backoff = s.initialBackoff
+			}
+			s.logger.Error("Service stopped unexpectedly, restarting", "service", service.String(), "backoff", backoff)
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-s.quit:
+			return
+		}
+
+		backoff = backoff * 2
+		if backoff > s.maxBackoff {
+			backoff = s.maxBackoff
+		}
+
+		// stopped service needs reset before it can be started again
+		if !service.IsRunning() {
+			service.Reset()
+		}
+	}
+}
@@ -0,0 +1,55 @@
+package cmd
+
+import (
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/common"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+// testService service which counts its starts
+type testService struct {
+	common.BaseService
+	starts int32
+}
+
+func newTestService() *testService {
+	service := &testService{}
+	service.BaseService = *common.NewBaseService(nil, "test-service", service)
+	return service
+}
+
+func (s *testService) OnStart() error {
+	atomic.AddInt32(&s.starts, 1)
+	return nil
+}
+
+func (s *testService) OnReset() error {
+	return nil
+}
+
+func TestSupervisorRestartsStoppedService(t *testing.T) {
+	service := newTestService()
+
+	supervisor := NewSupervisor([]common.Service{service}, log.NewNopLogger())
+	supervisor.initialBackoff = time.Millisecond
+	supervisor.maxBackoff = time.Millisecond
+	supervisor.Start()
+
+	require.Eventually(t, service.IsRunning, time.Second, time.Millisecond)
+
+	// service stops itself (eg. on subscription error)
+	require.NoError(t, service.Stop())
+	require.Eventually(t, func() bool {
+		return service.IsRunning() && atomic.LoadInt32(&service.starts) == 2
+	}, time.Second, time.Millisecond)
+
+	// stopped supervisor stops service and doesn't restart it
+	supervisor.Stop()
+	require.False(t, service.IsRunning())
+	time.Sleep(10 * time.Millisecond)
+	require.EqualValues(t, 2, atomic.LoadInt32(&service.starts))
+}
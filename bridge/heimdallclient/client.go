@@ -0,0 +1,431 @@
+// Package heimdallclient is typed client for heimdall rest server used by bridge services.
+//
+// Every request is bounded by per-attempt timeout, and failed requests (network errors,
+// timeouts and 5xx responses) are retried with jittered exponential backoff, rotating
+// through configured base URLs.
+package heimdallclient
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"net/url"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+const (
+	AccountSequenceURL     = "/auth/accounts/%v/sequence"
+	LastNoAckURL           = "/checkpoint/last-no-ack"
+	CheckpointParamsURL    = "/checkpoint/params"
+	ProposersURL           = "/staking/proposer/%v"
+	BufferedCheckpointURL  = "/checkpoint/buffer"
+	LatestCheckpointURL    = "/checkpoint/latest-checkpoint"
+	CurrentProposerURL     = "/staking/current-proposer"
+	LatestSpanURL          = "/bor/latest-span"
+	NextSpanInfoURL        = "/bor/prepare-next-span"
+	DividendAccountRootURL = "/staking/dividend-account-root"
+	ValidatorURL           = "/staking/validator/%v"
+	ClerkEventRecordURL    = "/clerk/event-record/%v"
+	StakingSequenceURL     = "/staking/sequence"
+	TopupSequenceURL       = "/topup/sequence"
+	PendingSideTxsURL      = "/sidechannel/pending"
+	SideTxVoteURL          = "/sidechannel/vote"
+
+	DefaultTimeout        = 10 * time.Second
+	DefaultMaxRetries     = 3
+	DefaultInitialBackoff = 500 * time.Millisecond
+	DefaultMaxBackoff     = 5 * time.Second
+)
+
+// Config client config
+type Config struct {
+	// rest server base urls, tried in order on failure
+	BaseURLs []string
+
+	// timeout of single attempt
+	Timeout time.Duration
+
+	// retries after first failed attempt, and backoff between them
+	MaxRetries     int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+
+	// http transport (default transport if nil)
+	Transport http.RoundTripper
+}
+
+// DefaultConfig returns default client config for given base urls
+func DefaultConfig(baseURLs ...string) Config {
+	return Config{
+		BaseURLs:       baseURLs,
+		Timeout:        DefaultTimeout,
+		MaxRetries:     DefaultMaxRetries,
+		InitialBackoff: DefaultInitialBackoff,
+		MaxBackoff:     DefaultMaxBackoff,
+	}
+}
+
+// StatusError is returned for non-200 response of rest server
+type StatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("Error while fetching data from url: %v, status: %v", e.URL, e.StatusCode)
+}
+
+// IsNotFound checks if error is returned for resource which doesn't exist (404 response)
+func IsNotFound(err error) bool {
+	statusErr, ok := err.(*StatusError)
+	return ok && statusErr.StatusCode == http.StatusNotFound
+}
+
+// Client heimdall rest client
+type Client struct {
+	cdc        *codec.Codec
+	config     Config
+	httpClient *http.Client
+
+	// index of base url which served last successful request
+	mu      sync.Mutex
+	current int
+}
+
+// NewClient creates new heimdall rest client
+func NewClient(cdc *codec.Codec, config Config) *Client {
+	if len(config.BaseURLs) == 0 {
+		panic("heimdallclient: no base url")
+	}
+
+	return &Client{
+		cdc:        cdc,
+		config:     config,
+		httpClient: &http.Client{Transport: config.Transport},
+	}
+}
+
+//
+// Typed queries
+//
+
+// LatestSpan fetches latest span
+func (c *Client) LatestSpan(ctx context.Context) (*hmTypes.Span, error) {
+	var span hmTypes.Span
+	if err := c.getJSON(ctx, LatestSpanURL, nil, &span); err != nil {
+		return nil, err
+	}
+	return &span, nil
+}
+
+// NextSpan fetches details of next span prepared by heimdall for given proposer
+func (c *Client) NextSpan(ctx context.Context, spanID uint64, startBlock uint64, chainID string, proposer string) (*hmTypes.Span, error) {
+	query := url.Values{}
+	query.Set("span_id", strconv.FormatUint(spanID, 10))
+	query.Set("start_block", strconv.FormatUint(startBlock, 10))
+	query.Set("chain_id", chainID)
+	query.Set("proposer", proposer)
+
+	var span hmTypes.Span
+	if err := c.getJSON(ctx, NextSpanInfoURL, query, &span); err != nil {
+		return nil, err
+	}
+	return &span, nil
+}
+
+// CurrentProposer fetches current checkpoint proposer
+func (c *Client) CurrentProposer(ctx context.Context) (*hmTypes.Validator, error) {
+	var proposer hmTypes.Validator
+	if err := c.getJSON(ctx, CurrentProposerURL, nil, &proposer); err != nil {
+		return nil, err
+	}
+	return &proposer, nil
+}
+
+// NextProposers fetches next `count` checkpoint proposers
+func (c *Client) NextProposers(ctx context.Context, count uint64) ([]hmTypes.Validator, error) {
+	var proposers []hmTypes.Validator
+	if err := c.getJSON(ctx, fmt.Sprintf(ProposersURL, count), nil, &proposers); err != nil {
+		return nil, err
+	}
+	return proposers, nil
+}
+
+// Validator fetches validator by id
+func (c *Client) Validator(ctx context.Context, id uint64) (*hmTypes.Validator, error) {
+	var validator hmTypes.Validator
+	if err := c.getJSON(ctx, fmt.Sprintf(ValidatorURL, id), nil, &validator); err != nil {
+		return nil, err
+	}
+	return &validator, nil
+}
+
+// BufferedCheckpoint fetches checkpoint in buffer
+func (c *Client) BufferedCheckpoint(ctx context.Context) (*hmTypes.CheckpointBlockHeader, error) {
+	var checkpoint hmTypes.CheckpointBlockHeader
+	if err := c.getJSON(ctx, BufferedCheckpointURL, nil, &checkpoint); err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// PendingSideTxs fetches side txs awaiting validator votes
+func (c *Client) PendingSideTxs(ctx context.Context) ([]sidechannelTypes.PendingSideTx, error) {
+	result, err := c.Get(ctx, PendingSideTxsURL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// side tx msgs are interfaces, decoded with amino
+	var txs []sidechannelTypes.PendingSideTx
+	if err := c.cdc.UnmarshalJSON(result, &txs); err != nil {
+		return nil, err
+	}
+	return txs, nil
+}
+
+// SideTxVote fetches vote of validator with given signer on pending side tx
+func (c *Client) SideTxVote(ctx context.Context, id hmTypes.HeimdallHash, voter hmTypes.HeimdallAddress) (*sidechannelTypes.SideTxVote, error) {
+	query := url.Values{}
+	query.Set("id", id.String())
+	query.Set("voter", voter.String())
+
+	var vote sidechannelTypes.SideTxVote
+	if err := c.getJSON(ctx, SideTxVoteURL, query, &vote); err != nil {
+		return nil, err
+	}
+	return &vote, nil
+}
+
+// LatestCheckpoint fetches last committed checkpoint
+func (c *Client) LatestCheckpoint(ctx context.Context) (*hmTypes.CheckpointBlockHeader, error) {
+	var checkpoint hmTypes.CheckpointBlockHeader
+	if err := c.getJSON(ctx, LatestCheckpointURL, nil, &checkpoint); err != nil {
+		return nil, err
+	}
+	return &checkpoint, nil
+}
+
+// CheckpointParams fetches checkpoint module params
+func (c *Client) CheckpointParams(ctx context.Context) (*checkpointTypes.Params, error) {
+	var params checkpointTypes.Params
+	if err := c.getJSON(ctx, CheckpointParamsURL, nil, &params); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+// DividendAccountRoot fetches root hash of dividend accounts
+func (c *Client) DividendAccountRoot(ctx context.Context) (hmTypes.HeimdallHash, error) {
+	var root hmTypes.HeimdallHash
+	err := c.getJSON(ctx, DividendAccountRootURL, nil, &root)
+	return root, err
+}
+
+// LastNoAck fetches time of last no-ack
+func (c *Client) LastNoAck(ctx context.Context) (uint64, error) {
+	var result struct {
+		Result uint64 `json:"result"`
+	}
+	if err := c.getJSON(ctx, LastNoAckURL, nil, &result); err != nil {
+		return 0, err
+	}
+	return result.Result, nil
+}
+
+// Account fetches account number and sequence of given address (empty account if it doesn't exist yet)
+func (c *Client) Account(ctx context.Context, address hmTypes.HeimdallAddress) (*authTypes.LightBaseAccount, error) {
+	result, err := c.Get(ctx, fmt.Sprintf(AccountSequenceURL, address), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var account authTypes.LightBaseAccount
+	if len(result) != 0 {
+		if err := c.cdc.UnmarshalJSON(result, &account); err != nil {
+			return nil, err
+		}
+	}
+	return &account, nil
+}
+
+// HasEventRecord checks if clerk event record with given id exists
+func (c *Client) HasEventRecord(ctx context.Context, id uint64) (bool, error) {
+	return c.exists(ctx, fmt.Sprintf(ClerkEventRecordURL, id), nil)
+}
+
+// HasStakingSequence checks if staking event from given mainchain log was processed
+func (c *Client) HasStakingSequence(ctx context.Context, txHash string, logIndex uint64) (bool, error) {
+	return c.exists(ctx, StakingSequenceURL, sequenceQuery(txHash, logIndex))
+}
+
+// HasTopupSequence checks if topup event from given mainchain log was processed
+func (c *Client) HasTopupSequence(ctx context.Context, txHash string, logIndex uint64) (bool, error) {
+	return c.exists(ctx, TopupSequenceURL, sequenceQuery(txHash, logIndex))
+}
+
+func sequenceQuery(txHash string, logIndex uint64) url.Values {
+	query := url.Values{}
+	query.Set("tx_hash", txHash)
+	query.Set("log_index", strconv.FormatUint(logIndex, 10))
+	return query
+}
+
+// exists checks if resource at given path exists, any response other than 404 is returned as error
+func (c *Client) exists(ctx context.Context, urlPath string, query url.Values) (bool, error) {
+	_, err := c.Get(ctx, urlPath, query)
+	if err == nil {
+		return true, nil
+	}
+	if IsNotFound(err) {
+		return false, nil
+	}
+	return false, err
+}
+
+//
+// Requests
+//
+
+// getJSON fetches result at given path and unmarshals it into v
+func (c *Client) getJSON(ctx context.Context, urlPath string, query url.Values, v interface{}) error {
+	result, err := c.Get(ctx, urlPath, query)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(result, v)
+}
+
+// Get fetches result at given path (with optional query), retrying failed attempts
+// and failing over to next base url
+func (c *Client) Get(ctx context.Context, urlPath string, query url.Values) (json.RawMessage, error) {
+	backoff := c.config.InitialBackoff
+
+	var err error
+	for attempt := 0; ; attempt++ {
+		index := c.currentIndex()
+
+		var result json.RawMessage
+		result, err = c.fetch(ctx, c.endpoint(index, urlPath, query))
+		if err == nil {
+			return result, nil
+		}
+
+		if attempt >= c.config.MaxRetries || !retryable(ctx, err) {
+			return nil, err
+		}
+
+		// next attempt goes to next base url
+		c.failover(index)
+
+		select {
+		case <-time.After(jitter(backoff)):
+		case <-ctx.Done():
+			return nil, err
+		}
+
+		backoff = backoff * 2
+		if backoff > c.config.MaxBackoff {
+			backoff = c.config.MaxBackoff
+		}
+	}
+}
+
+// fetch makes single request bounded by attempt timeout, and unwraps response
+func (c *Client) fetch(ctx context.Context, URL string) (json.RawMessage, error) {
+	if c.config.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.config.Timeout)
+		defer cancel()
+	}
+
+	req, err := http.NewRequest(http.MethodGet, URL, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, &StatusError{URL: URL, StatusCode: resp.StatusCode}
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	var response rest.ResponseWithHeight
+	if err := c.cdc.UnmarshalJSON(body, &response); err != nil {
+		return nil, err
+	}
+	return response.Result, nil
+}
+
+// endpoint returns url for path on base url with given index
+func (c *Client) endpoint(index int, urlPath string, query url.Values) string {
+	u, err := url.Parse(strings.TrimSpace(c.config.BaseURLs[index]))
+	if err != nil {
+		return c.config.BaseURLs[index] + urlPath
+	}
+
+	u.Path = path.Join(u.Path, urlPath)
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+func (c *Client) currentIndex() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.current
+}
+
+// failover moves to next base url, unless other request already did
+func (c *Client) failover(index int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.current == index {
+		c.current = (index + 1) % len(c.config.BaseURLs)
+	}
+}
+
+// retryable checks if request failed because of network error, timeout or server error
+// (4xx responses and canceled requests are not retried)
+func retryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	if statusErr, ok := err.(*StatusError); ok {
+		return statusErr.StatusCode >= http.StatusInternalServerError
+	}
+	_, isURLErr := err.(*url.Error)
+	return isURLErr
+}
+
+// jitter returns random duration in [d/2, d)
+func jitter(d time.Duration) time.Duration {
+	if d <= 1 {
+		return d
+	}
+	half := d / 2
+	return half + time.Duration(rand.Int63n(int64(half)))
+}
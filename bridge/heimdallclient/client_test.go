@@ -0,0 +1,102 @@
+package heimdallclient
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+)
+
+func testConfig(baseURLs ...string) Config {
+	config := DefaultConfig(baseURLs...)
+	config.Timeout = 100 * time.Millisecond
+	config.InitialBackoff = time.Millisecond
+	config.MaxBackoff = time.Millisecond
+	return config
+}
+
+func TestClientRetriesAndFailsOver(t *testing.T) {
+	var downCalls, upCalls int32
+
+	down := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&downCalls, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer down.Close()
+
+	up := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&upCalls, 1)
+		require.Equal(t, LastNoAckURL, r.URL.Path)
+		w.Write([]byte(`{"height":"1","result":{"result":42}}`))
+	}))
+	defer up.Close()
+
+	client := NewClient(codec.New(), testConfig(down.URL, up.URL))
+
+	lastNoAck, err := client.LastNoAck(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 42, lastNoAck)
+	require.EqualValues(t, 1, atomic.LoadInt32(&downCalls))
+
+	// healthy url is used for subsequent requests
+	_, err = client.LastNoAck(context.Background())
+	require.NoError(t, err)
+	require.EqualValues(t, 1, atomic.LoadInt32(&downCalls))
+	require.EqualValues(t, 2, atomic.LoadInt32(&upCalls))
+}
+
+func TestClientTimeoutAndNotFound(t *testing.T) {
+	var calls int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if r.URL.Path == LastNoAckURL {
+			// hung server
+			time.Sleep(time.Second)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer server.Close()
+
+	client := NewClient(codec.New(), testConfig(server.URL))
+
+	// every attempt times out
+	_, err := client.LastNoAck(context.Background())
+	require.Error(t, err)
+	require.EqualValues(t, DefaultMaxRetries+1, atomic.LoadInt32(&calls))
+
+	// missing resource is not retried
+	atomic.StoreInt32(&calls, 0)
+	exists, err := client.HasTopupSequence(context.Background(), "0x01", 1)
+	require.NoError(t, err)
+	require.False(t, exists)
+	require.EqualValues(t, 1, atomic.LoadInt32(&calls))
+}
+
+func TestExistsOnlyTreatsNotFoundAsMissing(t *testing.T) {
+	status := int32(http.StatusBadRequest)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(int(atomic.LoadInt32(&status)))
+	}))
+	defer server.Close()
+
+	client := NewClient(codec.New(), testConfig(server.URL))
+
+	// other client errors and querier failures are not reported as missing resource
+	for _, code := range []int32{http.StatusBadRequest, http.StatusInternalServerError} {
+		atomic.StoreInt32(&status, code)
+		_, err := client.HasStakingSequence(context.Background(), "0x01", 1)
+		require.Error(t, err)
+		require.False(t, IsNotFound(err))
+	}
+
+	atomic.StoreInt32(&status, http.StatusNotFound)
+	exists, err := client.HasStakingSequence(context.Background(), "0x01", 1)
+	require.NoError(t, err)
+	require.False(t, exists)
+}
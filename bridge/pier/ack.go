@@ -3,8 +3,6 @@ package pier
 import (
 	"bytes"
 	"context"
-	"encoding/json"
-	"fmt"
 	"math"
 	"math/big"
 	"strconv"
@@ -18,17 +16,13 @@ import (
 	"github.com/tendermint/tendermint/libs/common"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/contracts/rootchain"
 	"github.com/maticnetwork/heimdall/helper"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 )
 
-// Result represents single req result
-type Result struct {
-	Result uint64 `json:"result"`
-}
-
 type AckService struct {
 	// Base service
 	common.BaseService
@@ -50,6 +44,9 @@ type AckService struct {
 
 	// http client to subscribe to
 	httpClient *httpClient.HTTP
+
+	// heimdall rest client
+	heimdallClient *heimdallclient.Client
 }
 
 // NewAckService returns new service object
@@ -76,6 +73,7 @@ func NewAckService(cdc *codec.Codec, queueConnector *QueueConnector, httpClient
 		cliCtx:         cliCtx,
 		queueConnector: queueConnector,
 		httpClient:     httpClient,
+		heimdallClient: NewHeimdallClient(cdc),
 	}
 
 	ackservice.BaseService = *common.NewBaseService(logger, NoackService, ackservice)
@@ -104,8 +102,11 @@ func (ackService *AckService) OnStop() {
 
 	// cancel ack process
 	ackService.cancelACKProcess()
-	// close bridge db instance
-	closeBridgeDBInstance()
+}
+
+// OnReset allows supervisor to restart stopped service
+func (ackService *AckService) OnReset() error {
+	return nil
 }
 
 func (ackService *AckService) startPollingCheckpoint(ctx context.Context, interval time.Duration) {
@@ -197,54 +198,45 @@ func (ackService *AckService) processCheckpoint(lastCreatedAt int64) {
 			"proposerCount", index,
 		)
 
+		// no-ack timestamp is checked against block time, use time of latest heimdall block
+		timestamp, err := getLatestBlockTime(ackService.cliCtx)
+		if err != nil {
+			ackService.Logger.Error("Error while fetching latest heimdall block time", "error", err)
+			return
+		}
+
 		// send NO ACK
 		msg := checkpointTypes.NewMsgCheckpointNoAck(
 			hmtypes.BytesToHeimdallAddress(helper.GetAddress()),
-			uint64(time.Now().UTC().Unix()),
+			timestamp,
 		)
 
 		// send
-		err := ackService.queueConnector.BroadcastToHeimdall(msg)
-		if err != nil {
+		if err := ackService.queueConnector.BroadcastToHeimdall(msg); err != nil {
 			ackService.Logger.Error("Error while sending no-ack tx to Heimdall queue", "error", err)
 			return
 		}
 
+		noAcksSent.Inc()
 		ackService.Logger.Info("No-ack transaction sent successfully", "index", index)
 	}
 }
 
 func (ackService *AckService) getLastNoAckTime() uint64 {
-	response, err := FetchFromAPI(ackService.cliCtx, GetHeimdallServerEndpoint(LastNoAckURL))
+	lastNoAck, err := ackService.heimdallClient.LastNoAck(context.Background())
 	if err != nil {
-		ackService.Logger.Error("Unable to send request for checkpoint buffer", "Error", err)
+		ackService.Logger.Error("Unable to fetch last no-ack", "Error", err)
 		return 0
 	}
 
-	var noackObject Result
-	if err := json.Unmarshal(response.Result, &noackObject); err != nil {
-		ackService.Logger.Error("Error unmarshalling no-ack data ", "error", err)
-		return 0
-	}
-
-	return noackObject.Result
+	return lastNoAck
 }
 
 func (ackService *AckService) isValidProposer(count uint64, address []byte) bool {
 	ackService.Logger.Debug("Skipping proposers", "count", strconv.FormatUint(count, 10))
-	response, err := FetchFromAPI(
-		ackService.cliCtx,
-		GetHeimdallServerEndpoint(fmt.Sprintf(ProposersURL, strconv.FormatUint(count, 10))),
-	)
+	proposers, err := ackService.heimdallClient.NextProposers(context.Background(), count)
 	if err != nil {
-		ackService.Logger.Error("Unable to send request for next proposers", "Error", err)
-		return false
-	}
-
-	// unmarshall data from buffer
-	var proposers []hmtypes.Validator
-	if err := json.Unmarshal(response.Result, &proposers); err != nil {
-		ackService.Logger.Error("Error unmarshalling validator data ", "error", err)
+		ackService.Logger.Error("Unable to fetch next proposers", "Error", err)
 		return false
 	}
 
@@ -259,20 +251,5 @@ func (ackService *AckService) isValidProposer(count uint64, address []byte) bool
 }
 
 func (ackService *AckService) getCheckpointParams() (*checkpointTypes.Params, error) {
-	response, err := FetchFromAPI(
-		ackService.cliCtx,
-		GetHeimdallServerEndpoint(CheckpointParamsURL),
-	)
-
-	if err != nil {
-		return nil, err
-	}
-
-	var params checkpointTypes.Params
-	if err := json.Unmarshal(response.Result, &params); err != nil {
-		ackService.Logger.Error("Error unmarshalling checkpoint params", "error", err)
-		return nil, err
-	}
-
-	return &params, nil
+	return ackService.heimdallClient.CheckpointParams(context.Background())
 }
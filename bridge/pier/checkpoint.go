@@ -4,10 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
-	"encoding/json"
 	"fmt"
 	"math/big"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -23,8 +23,10 @@ import (
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
 	hmtypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -38,10 +40,16 @@ type Checkpointer struct {
 	HeaderChannel chan *types.Header
 	// cancel function for poll/subscription
 	cancelSubscription context.CancelFunc
+	// consecutive new head subscription failures
+	subscriptionFailures int32 // accessed atomically
 	// header listener subscription
 	cancelHeaderProcess context.CancelFunc
 	// contract caller
 	contractConnector helper.ContractCaller
+	// tx manager for mainchain txs
+	txManager *helper.TxManager
+	// checkpoints are only logged, not submitted to mainchain
+	dryRun bool
 	// tx encoder
 	txEncoder authTypes.TxBuilder
 
@@ -51,6 +59,8 @@ type Checkpointer struct {
 	queueConnector *QueueConnector
 	// http client to subscribe to
 	httpClient *httpClient.HTTP
+	// heimdall rest client
+	heimdallClient *heimdallclient.Client
 }
 
 // NewCheckpointer returns new service object
@@ -69,16 +79,33 @@ func NewCheckpointer(cdc *codec.Codec, queueConnector *QueueConnector, httpClien
 		panic(err)
 	}
 
+	// storage client
+	storageClient := getBridgeDBInstance(viper.GetString(BridgeDBFlag))
+
+	// tx manager for checkpoint submission
+	txManager, err := helper.NewTxManager("main", helper.GetMainClient(), storageClient)
+	if err != nil {
+		logger.Error("Error while creating mainchain tx manager", "error", err)
+		panic(err)
+	}
+
+	// checkpoint tx is signed but not sent in dry-run mode
+	dryRun := viper.GetBool(DryRunFlag)
+	txManager.SetDryRun(dryRun)
+
 	// creating checkpointer object
 	checkpointer := &Checkpointer{
-		storageClient:     getBridgeDBInstance(viper.GetString(BridgeDBFlag)),
+		storageClient:     storageClient,
 		HeaderChannel:     make(chan *types.Header),
 		contractConnector: contractCaller,
+		txManager:         txManager,
+		dryRun:            dryRun,
 		txEncoder:         authTypes.NewTxBuilderFromCLI().WithTxEncoder(helper.GetTxEncoder(cdc)).WithChainID(helper.GetGenesisDoc().ChainID),
 
 		cliCtx:         cliCtx,
 		queueConnector: queueConnector,
 		httpClient:     httpClient,
+		heimdallClient: NewHeimdallClient(cdc),
 	}
 
 	checkpointer.BaseService = *common.NewBaseService(logger, HeimdallCheckpointer, checkpointer)
@@ -90,7 +117,7 @@ func (c *Checkpointer) startHeaderProcess(ctx context.Context) {
 	for {
 		select {
 		case newHeader := <-c.HeaderChannel:
-			if isProposer(c.cliCtx) {
+			if isProposer(c.heimdallClient) {
 				c.sendRequest(newHeader)
 			}
 		case <-ctx.Done():
@@ -114,6 +141,16 @@ func (c *Checkpointer) OnStart() error {
 	// start header process
 	go c.startHeaderProcess(headerCtx)
 
+	// start tracking pending checkpoint txs
+	c.txManager.Start()
+
+	// poll for new headers if subscription keeps failing
+	if failures := atomic.LoadInt32(&c.subscriptionFailures); failures >= maxSubscriptionFailures {
+		c.Logger.Info("New head subscription keeps failing, polling for new headers instead", "failures", failures)
+		go c.startPolling(ctx, helper.GetConfig().CheckpointerPollInterval)
+		return nil
+	}
+
 	// subscribe to new head
 	subscription, err := c.contractConnector.MaticChainClient.SubscribeNewHead(ctx, c.HeaderChannel)
 	if err != nil {
@@ -134,14 +171,19 @@ func (c *Checkpointer) OnStart() error {
 func (c *Checkpointer) OnStop() {
 	c.BaseService.OnStop() // Always call the overridden method.
 
-	// close bridge db instance
-	closeBridgeDBInstance()
-
 	// cancel subscription if any
 	c.cancelSubscription()
 
 	// cancel header process
 	c.cancelHeaderProcess()
+
+	// stop tracking pending txs
+	c.txManager.Stop()
+}
+
+// OnReset allows supervisor to restart stopped service
+func (c *Checkpointer) OnReset() error {
+	return nil
 }
 
 func (c *Checkpointer) startPolling(ctx context.Context, pollInterval time.Duration) {
@@ -171,11 +213,17 @@ func (c *Checkpointer) startPolling(ctx context.Context, pollInterval time.Durat
 }
 
 func (c *Checkpointer) startSubscription(ctx context.Context, subscription ethereum.Subscription) {
+	subscribedAt := time.Now()
 	for {
 		select {
 		case err := <-subscription.Err():
-			// stop service
-			c.Logger.Error("Error while subscribing new blocks", "error", err)
+			if time.Since(subscribedAt) >= subscriptionStablePeriod {
+				atomic.StoreInt32(&c.subscriptionFailures, 0)
+			}
+			failures := atomic.AddInt32(&c.subscriptionFailures, 1)
+
+			// stop service, supervisor restarts it
+			c.Logger.Error("Error while subscribing new blocks", "failures", failures, "error", err)
 			c.Stop()
 
 			// cancel subscription
@@ -193,10 +241,9 @@ func (c *Checkpointer) sendRequest(newHeader *types.Header) {
 	// get state
 	var expectedCheckpointState *ContractCheckpoint
 	var bufferedCheckpoint *HeimdallCheckpoint
-	var committedCheckpoint *HeimdallCheckpoint
 
 	var wg sync.WaitGroup
-	wg.Add(3)
+	wg.Add(2)
 
 	c.Logger.Debug("Collecting contract and heimdall checkpoint state")
 
@@ -210,11 +257,6 @@ func (c *Checkpointer) sendRequest(newHeader *types.Header) {
 		bufferedCheckpoint, _ = c.fetchBufferedCheckpoint()
 	}()
 
-	go func() {
-		defer wg.Done()
-		committedCheckpoint, _ = c.fetchCommittedCheckpoint()
-	}()
-
 	// wait for state collection
 	wg.Wait()
 
@@ -235,15 +277,7 @@ func (c *Checkpointer) sendRequest(newHeader *types.Header) {
 		"currentEnd", expectedCheckpointState.currentHeaderBlock.end,
 	)
 
-	// TODO
-	if committedCheckpoint == nil {
-	}
-
-	//
-	// Check if ACK is needed
-	//
-
-	// buffer checkpoint log
+	// buffer checkpoint log, ack for it is sent by syncer on rootchain NewHeaderBlock event
 	if bufferedCheckpoint == nil {
 		c.Logger.Debug("Buffer not found")
 	} else if bufferedCheckpoint.start != 0 {
@@ -253,27 +287,6 @@ func (c *Checkpointer) sendRequest(newHeader *types.Header) {
 		)
 	}
 
-	if bufferedCheckpoint != nil &&
-		expectedCheckpointState != nil &&
-		expectedCheckpointState.currentHeaderBlock.start == bufferedCheckpoint.start {
-
-		// expected checkpoint state
-		// c.Logger.Debug("Sending ACK",
-		// 	"bufferedCheckpointEnd", bufferedCheckpoint.end,
-		// 	"contractStart", bufferedCheckpoint.start,
-		// )
-
-		// // calculate header index
-		// headerNumber := expectedCheckpointState.currentHeaderBlock.Sub(
-		// 	expectedCheckpointState.currentHeaderBlock,
-		// 	big.NewInt(int64(helper.GetConfig().ChildBlockInterval)),
-		// )
-
-		// if err := c.broadcastACK(expectedCheckpointState.currentHeaderBlock.number.Uint64()); err != nil {
-		// 	c.Logger.Error("Error while sending ACK", "Error", err.Error())
-		// }
-	}
-
 	//
 	// Send checkpoint if valid
 	//
@@ -305,6 +318,13 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 		return nil, err
 	}
 
+	// fetch checkpoint sizing params from heimdall
+	params, err := c.heimdallClient.CheckpointParams(context.Background())
+	if err != nil {
+		c.Logger.Error("Error while fetching checkpoint params", "error", err)
+		return nil, err
+	}
+
 	//
 	// find next start/end
 	//
@@ -322,14 +342,14 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 
 	// process if diff > 0 (positive)
 	if diff > 0 {
-		expectedDiff := diff - diff%helper.GetConfig().AvgCheckpointLength
+		expectedDiff := diff - diff%params.AvgCheckpointLength
 		if expectedDiff > 0 {
 			expectedDiff = expectedDiff - 1
 		}
 
 		// cap with max checkpoint length
-		if expectedDiff > helper.GetConfig().MaxCheckpointLength-1 {
-			expectedDiff = helper.GetConfig().MaxCheckpointLength - 1
+		if expectedDiff > params.MaxCheckpointLength-1 {
+			expectedDiff = params.MaxCheckpointLength - 1
 		}
 
 		// get end result
@@ -343,17 +363,17 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 	}
 
 	// Handle when block producers go down
-	if end == 0 || end == start || (0 < diff && diff < helper.GetConfig().AvgCheckpointLength) {
+	if end == 0 || end == start || (0 < diff && diff < params.AvgCheckpointLength) {
 		c.Logger.Debug("Fetching last header block to calculate time")
 
 		currentTime := time.Now().UTC().Unix()
-		defaultForcePushInterval := helper.GetConfig().MaxCheckpointLength * 2 // in seconds (1024 * 2 seconds)
-		if currentTime-int64(lastCheckpointTime) > int64(defaultForcePushInterval) {
+		forcePushInterval := int64(params.ForcePushInterval.Seconds())
+		if currentTime-int64(lastCheckpointTime) > forcePushInterval {
 			end = latestChildBlock
 			c.Logger.Info("Force push checkpoint",
 				"currentTime", currentTime,
 				"lastCheckpointTime", lastCheckpointTime,
-				"defaultForcePushInterval", defaultForcePushInterval,
+				"forcePushInterval", forcePushInterval,
 				"start", start,
 				"end", end,
 			)
@@ -376,7 +396,7 @@ func (c *Checkpointer) nextExpectedCheckpoint(latestChildBlock uint64) (*Contrac
 func (c *Checkpointer) fetchBufferedCheckpoint() (*HeimdallCheckpoint, error) {
 	c.Logger.Info("Fetching checkpoint in buffer")
 
-	_checkpoint, err := c.fetchCheckpoint(GetHeimdallServerEndpoint(BufferedCheckpointURL))
+	_checkpoint, err := c.heimdallClient.BufferedCheckpoint(context.Background())
 	if err != nil {
 		return nil, err
 	}
@@ -385,46 +405,14 @@ func (c *Checkpointer) fetchBufferedCheckpoint() (*HeimdallCheckpoint, error) {
 	return bufferedCheckpoint, nil
 }
 
-// fetches latest committed checkpoint from heimdall
-func (c *Checkpointer) fetchCommittedCheckpoint() (*HeimdallCheckpoint, error) {
-	c.Logger.Info("Fetching last committed checkpoint")
-
-	_checkpoint, err := c.fetchCheckpoint(GetHeimdallServerEndpoint(LatestCheckpointURL))
-	if err != nil {
-		return nil, err
-	}
-
-	return NewHeimdallCheckpoint(_checkpoint.StartBlock, _checkpoint.EndBlock), nil
-}
-
-// fetches checkpoint from given URL
-func (c *Checkpointer) fetchCheckpoint(url string) (checkpoint hmtypes.CheckpointBlockHeader, err error) {
-	response, err := FetchFromAPI(c.cliCtx, url)
-	if err != nil {
-		return checkpoint, err
-	}
-
-	if err := json.Unmarshal(response.Result, &checkpoint); err != nil {
-		c.Logger.Error("Error unmarshalling checkpoint", "error", err)
-		return checkpoint, err
-	}
-
-	return checkpoint, nil
-}
-
 // fetches dividend accountroothash
 func (c *Checkpointer) fetchDividendAccountRoot() (accountroothash hmtypes.HeimdallHash, err error) {
 	c.Logger.Info("Sending Rest call to Get Dividend AccountRootHash")
-	response, err := FetchFromAPI(c.cliCtx, GetHeimdallServerEndpoint(DividendAccountRootURL))
+	accountroothash, err = c.heimdallClient.DividendAccountRoot(context.Background())
 	if err != nil {
 		c.Logger.Error("Error Fetching accountroothash from HeimdallServer ", "error", err)
 		return accountroothash, err
 	}
-
-	if err := json.Unmarshal(response.Result, &accountroothash); err != nil {
-		c.Logger.Error("Error unmarshalling accountroothash received from Heimdall Server", "error", err)
-		return accountroothash, err
-	}
 	return accountroothash, nil
 }
 
@@ -455,6 +443,12 @@ func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error
 		"accountRoot", accountRootHash,
 	)
 
+	// checkpoint timestamp is checked against block time, use time of latest heimdall block
+	timestamp, err := getLatestBlockTime(c.cliCtx)
+	if err != nil {
+		return err
+	}
+
 	// create and send checkpoint message
 	msg := checkpointTypes.NewMsgCheckpointBlock(
 		hmtypes.BytesToHeimdallAddress(helper.GetAddress()),
@@ -462,7 +456,7 @@ func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error
 		end,
 		hmtypes.BytesToHeimdallHash(root),
 		accountRootHash,
-		uint64(time.Now().UTC().Unix()),
+		timestamp,
 	)
 
 	// return broadcast to heimdall
@@ -471,25 +465,17 @@ func (c *Checkpointer) sendCheckpointToHeimdall(start uint64, end uint64) error
 	}
 
 	// wait for checkpoint to confirm and commit
-	go c.commitCheckpoint(start, end)
+	go c.commitCheckpoint(start, end, msg.RootHash)
 
 	return nil
 }
 
-// broadcastACK broadcasts ack for a checkpoint to heimdall
-// func (c *Checkpointer) broadcastACK(headerID uint64) error {
-// 	// create and send checkpoint ACK message
-// 	msg := checkpoint.NewMsgCheckpointAck(hmtypes.BytesToHeimdallAddress(helper.GetAddress()), headerID)
-// 	// broadcast ack
-// 	return c.queueConnector.BroadcastToHeimdall(msg)
-// }
-
 // wait for heimdall checkpoint tx to get confirmed and dispatch checkpoint
-func (c *Checkpointer) commitCheckpoint(startBlock uint64, endBlock uint64) {
-	// create tag query
+func (c *Checkpointer) commitCheckpoint(startBlock uint64, endBlock uint64, rootHash hmtypes.HeimdallHash) {
+	// create tag query, checkpoint tx adds side tx identified by root hash and start block
 	var tags []string
-	tags = append(tags, fmt.Sprintf("checkpoint.start-block='%v'", startBlock))
-	tags = append(tags, fmt.Sprintf("checkpoint.end-block='%v'", endBlock))
+	tags = append(tags, fmt.Sprintf("%v.%v='%v'", sidechannelTypes.EventTypeSideTx, sidechannelTypes.AttributeKeyTxHash, rootHash.String()))
+	tags = append(tags, fmt.Sprintf("%v.%v='%v'", sidechannelTypes.EventTypeSideTx, sidechannelTypes.AttributeKeyLogIndex, startBlock))
 	tags = append(tags, "message.action='checkpoint'")
 
 	// handler
@@ -548,6 +534,12 @@ func (c *Checkpointer) commitCheckpoint(startBlock uint64, endBlock uint64) {
 func (c *Checkpointer) dispatchCheckpoint(height int64, txHash []byte, start uint64, end uint64) error {
 	c.Logger.Debug("Preparing checkpoint to be pushed on chain")
 
+	// checkpoint is pushed only after validators voted it into buffer
+	bufferedCheckpoint, err := c.heimdallClient.BufferedCheckpoint(context.Background())
+	if err != nil || bufferedCheckpoint.StartBlock != start || bufferedCheckpoint.EndBlock != end {
+		return errors.New("Checkpoint is not approved by validators yet")
+	}
+
 	// proof
 	tx, err := helper.QueryTxWithProof(c.cliCtx, txHash)
 	if err != nil {
@@ -569,18 +561,9 @@ func (c *Checkpointer) dispatchCheckpoint(height int64, txHash []byte, start uin
 
 	// fetch current proposer from heimdall
 	validatorAddress := ethCommon.BytesToAddress(helper.GetPubKey().Address().Bytes())
-	var proposer hmtypes.Validator
-
-	// fetch latest start block from heimdall via rest query
-	response, err := FetchFromAPI(c.cliCtx, GetHeimdallServerEndpoint(CurrentProposerURL))
+	proposer, err := c.heimdallClient.CurrentProposer(context.Background())
 	if err != nil {
-		c.Logger.Error("Failed to get current proposer through rest")
-		return err
-	}
-
-	// get proposer from response
-	if err := json.Unmarshal(response.Result, &proposer); err != nil {
-		c.Logger.Error("Error unmarshalling validator", "error", err)
+		c.Logger.Error("Failed to get current proposer through rest", "error", err)
 		return err
 	}
 
@@ -592,7 +575,16 @@ func (c *Checkpointer) dispatchCheckpoint(height int64, txHash []byte, start uin
 		// check if we need to send checkpoint or not
 		if ((currentChildBlock + 1) == start) || (currentChildBlock == 0 && start == 0) {
 			c.Logger.Info("Checkpoint Valid", "startBlock", start)
-			c.contractConnector.SendCheckpoint(helper.GetVoteBytes(votes, chainID), sigs, tx.Tx[authTypes.PulpHashLength:])
+			if err := c.contractConnector.SendCheckpoint(helper.GetVoteBytes(votes, chainID), sigs, tx.Tx[authTypes.PulpHashLength:], c.txManager); err != nil {
+				checkpointSubmissions.WithLabelValues(checkpointSubmissionFailure).Inc()
+				return err
+			}
+
+			if c.dryRun {
+				dryRunActions.WithLabelValues(dryRunTargetMainchain, "checkpoint").Inc()
+			} else {
+				checkpointSubmissions.WithLabelValues(checkpointSubmissionSuccess).Inc()
+			}
 		} else if currentChildBlock > start {
 			c.Logger.Info("Start block does not match, checkpoint already sent", "commitedLastBlock", currentChildBlock, "startBlock", start)
 		} else if currentChildBlock > end {
@@ -21,6 +21,7 @@ import (
 	"github.com/tendermint/tendermint/libs/common"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
 	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
@@ -52,6 +53,9 @@ type ClerkService struct {
 
 	// http client to subscribe to
 	httpClient *httpClient.HTTP
+
+	// heimdall rest client
+	heimdallClient *heimdallclient.Client
 }
 
 // NewClerkService returns new service object
@@ -77,6 +81,7 @@ func NewClerkService(cdc *codec.Codec, queueConnector *QueueConnector, httpClien
 		cliCtx:         cliCtx,
 		queueConnector: queueConnector,
 		httpClient:     httpClient,
+		heimdallClient: NewHeimdallClient(cdc),
 	}
 
 	clerkService.BaseService = *common.NewBaseService(logger, ClerkServiceStr, clerkService)
@@ -103,12 +108,14 @@ func (s *ClerkService) OnStart() error {
 // OnStop stops all necessary go routines
 func (s *ClerkService) OnStop() {
 	s.BaseService.OnStop()
-	s.httpClient.Stop()
 
 	// cancel ack process
 	s.cancel()
-	// close bridge db instance
-	closeBridgeDBInstance()
+}
+
+// OnReset allows supervisor to restart stopped service
+func (s *ClerkService) OnReset() error {
+	return nil
 }
 
 // polls heimdall and checks if new span needs to be proposed
@@ -220,25 +227,19 @@ func (s *ClerkService) fetchLastEventRecordID() (uint64, error) {
 func (s *ClerkService) saveLastEventRecordID(result uint64) {
 	// set last block to storage
 	s.storageClient.Put(lastEventRecordKey, []byte(strconv.FormatUint(result, 10)), nil)
+	lastClerkRecordID.Set(float64(result))
 }
 
 // checks state counter
 func (s *ClerkService) getStateSyncerCounter() (*hmTypes.Span, error) {
 	// fetch latest start block from heimdall via rest query
-	result, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(LatestSpanURL))
-	if err != nil {
-		s.Logger.Error("Error while fetching latest span")
-		return nil, err
-	}
-
-	var lastSpan hmTypes.Span
-	err = json.Unmarshal(result.Result, &lastSpan)
+	lastSpan, err := s.heimdallClient.LatestSpan(context.Background())
 	if err != nil {
-		s.Logger.Error("Error unmarshalling", "error", err)
+		s.Logger.Error("Error while fetching latest span", "error", err)
 		return nil, err
 	}
 
-	return &lastSpan, nil
+	return lastSpan, nil
 }
 
 // isRecordProposer check if current user is proposer
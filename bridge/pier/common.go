@@ -4,25 +4,19 @@ import (
 	"bytes"
 	"context"
 	"encoding/hex"
-	"encoding/json"
-	"fmt"
-	"io/ioutil"
-	"net/http"
-	"net/url"
 	"os"
-	"path"
-	"strconv"
+	"strings"
 	"time"
 
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/pkg/errors"
 	"github.com/tendermint/tendermint/libs/log"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 	tmTypes "github.com/tendermint/tendermint/types"
 
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
 	"github.com/maticnetwork/heimdall/helper"
-	hmtypes "github.com/maticnetwork/heimdall/types"
-	rest "github.com/maticnetwork/heimdall/types/rest"
 )
 
 const (
@@ -31,27 +25,19 @@ const (
 	NoackService         = "checkpoint-no-ack"
 	SpanServiceStr       = "span-service"
 	ClerkServiceStr      = "clerk-service"
+	SideTxServiceStr     = "sidetx-service"
 	AMQPConsumerService  = "amqp-consumer-service"
 
-	// TxsURL represents txs url
-	TxsURL = "/txs"
-
-	AccountDetailsURL      = "/auth/accounts/%v"
-	LastNoAckURL           = "/checkpoint/last-no-ack"
-	CheckpointParamsURL    = "/checkpoint/params"
-	ProposersURL           = "/staking/proposer/%v"
-	BufferedCheckpointURL  = "/checkpoint/buffer"
-	LatestCheckpointURL    = "/checkpoint/latest-checkpoint"
-	CurrentProposerURL     = "/staking/current-proposer"
-	LatestSpanURL          = "/bor/latest-span"
-	NextSpanInfoURL        = "/bor/prepare-next-span"
-	DividendAccountRootURL = "/staking/dividend-account-root"
-	ValidatorURL           = "/staking/validator/%v"
-
 	TransactionTimeout = 1 * time.Minute
 	CommitTimeout      = 2 * time.Minute
 
+	// consecutive new head subscription failures after which services poll for new headers
+	maxSubscriptionFailures = 3
+	// subscription which lasted longer than this is considered healthy, resetting failure count
+	subscriptionStablePeriod = 5 * time.Minute
+
 	BridgeDBFlag = "bridge-db"
+	DryRunFlag   = "dry-run"
 )
 
 // Global logger for bridge
@@ -62,19 +48,14 @@ func init() {
 }
 
 // checks if we are proposer
-func isProposer(cliCtx cliContext.CLIContext) bool {
-	var proposers []hmtypes.Validator
-	count := uint64(1)
-	result, err := FetchFromAPI(cliCtx,
-		GetHeimdallServerEndpoint(fmt.Sprintf(ProposersURL, strconv.FormatUint(count, 10))),
-	)
+func isProposer(heimdallClient *heimdallclient.Client) bool {
+	proposers, err := heimdallClient.NextProposers(context.Background(), 1)
 	if err != nil {
 		Logger.Error("Error fetching proposers", "error", err)
 		return false
 	}
-	err = json.Unmarshal(result.Result, &proposers)
-	if err != nil {
-		Logger.Error("error unmarshalling proposer slice", "error", err)
+	if len(proposers) == 0 {
+		Logger.Error("No proposers fetched")
 		return false
 	}
 	Logger.Debug("Current proposer fetched", "validator", proposers[0].String())
@@ -87,20 +68,10 @@ func isProposer(cliCtx cliContext.CLIContext) bool {
 }
 
 // check if we are the EventSender
-func isEventSender(cliCtx cliContext.CLIContext, validatorID uint64) bool {
-
-	var validator hmtypes.Validator
-
-	result, err := FetchFromAPI(cliCtx,
-		GetHeimdallServerEndpoint(fmt.Sprintf(ValidatorURL, strconv.FormatUint(validatorID, 10))),
-	)
+func isEventSender(heimdallClient *heimdallclient.Client, validatorID uint64) bool {
+	validator, err := heimdallClient.Validator(context.Background(), validatorID)
 	if err != nil {
-		Logger.Error("Error fetching proposers", "error", err)
-		return false
-	}
-	err = json.Unmarshal(result.Result, &validator)
-	if err != nil {
-		Logger.Error("error unmarshalling proposer slice", "error", err)
+		Logger.Error("Error fetching validator", "error", err)
 		return false
 	}
 	Logger.Debug("Current event sender received", "validator", validator.String())
@@ -110,40 +81,14 @@ func isEventSender(cliCtx cliContext.CLIContext, validatorID uint64) bool {
 	}
 
 	return false
-
 }
 
-// GetHeimdallServerEndpoint returns heimdall server endpoint
-func GetHeimdallServerEndpoint(endpoint string) string {
-	u, _ := url.Parse(helper.GetConfig().HeimdallServerURL)
-	u.Path = path.Join(u.Path, endpoint)
-	return u.String()
-}
-
-// FetchFromAPI fetches data from any URL
-func FetchFromAPI(cliCtx cliContext.CLIContext, URL string) (result rest.ResponseWithHeight, err error) {
-	resp, err := http.Get(URL)
-	if err != nil {
-		return result, err
-	}
-	defer resp.Body.Close()
-
-	// response
-	if resp.StatusCode == 200 {
-		body, err := ioutil.ReadAll(resp.Body)
-		if err != nil {
-			return result, err
-		}
-		// unmarshall data from buffer
-		var response rest.ResponseWithHeight
-		if err := cliCtx.Codec.UnmarshalJSON(body, &response); err != nil {
-			return result, err
-		}
-		return response, nil
-	}
-
-	Logger.Debug("Error while fetching data from URL", "status", resp.StatusCode, "URL", URL)
-	return result, fmt.Errorf("Error while fetching data from url: %v, status: %v", URL, resp.StatusCode)
+// NewHeimdallClient creates rest client for heimdall server(s) in config
+// (`heimdall_rest_server` accepts comma separated urls), recording rpc metrics
+func NewHeimdallClient(cdc *codec.Codec) *heimdallclient.Client {
+	config := heimdallclient.DefaultConfig(strings.Split(helper.GetConfig().HeimdallServerURL, ",")...)
+	config.Transport = NewMetricsTransport(nil)
+	return heimdallclient.NewClient(cdc, config)
 }
 
 // WaitForOneEvent subscribes to a websocket event for the given
@@ -202,6 +147,16 @@ func FetchVotes(
 	return preCommits, valSigs, chainID, nil
 }
 
+// getLatestBlockTime returns time of latest heimdall block (unix seconds),
+// used for msg timestamps which heimdall checks against block time
+func getLatestBlockTime(cliCtx cliContext.CLIContext) (uint64, error) {
+	status, err := helper.GetNodeStatus(cliCtx)
+	if err != nil {
+		return 0, err
+	}
+	return uint64(status.SyncInfo.LatestBlockTime.Unix()), nil
+}
+
 // IsCatchingUp checks if the heimdall node you are connected to is fully synced or not
 // returns true when synced
 func IsCatchingUp(cliCtx cliContext.CLIContext) bool {
@@ -59,9 +59,22 @@ func (consumer *ConsumerService) OnStart() error {
 		return err
 	}
 
+	// stop service once delivery stops, so supervisor restarts it
+	go consumer.watchDelivery(consumer.queueConnector.DeliveryStopped(), consumer.Quit())
+
 	return nil
 }
 
+// watchDelivery stops consumer when queue delivery stops on its own
+func (consumer *ConsumerService) watchDelivery(deliveryStopped <-chan struct{}, quit <-chan struct{}) {
+	select {
+	case <-deliveryStopped:
+		consumer.Logger.Error("Queue delivery stopped, stopping consumer")
+		consumer.Stop()
+	case <-quit:
+	}
+}
+
 // OnStop stops all necessary go routines
 func (consumer *ConsumerService) OnStop() {
 	// Always call the overridden method.
@@ -69,7 +82,9 @@ func (consumer *ConsumerService) OnStop() {
 
 	// queue stop
 	consumer.queueConnector.Stop()
+}
 
-	// close db
-	closeBridgeDBInstance()
+// OnReset allows supervisor to restart stopped service
+func (consumer *ConsumerService) OnReset() error {
+	return nil
 }
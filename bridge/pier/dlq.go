@@ -0,0 +1,228 @@
+package pier
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spf13/viper"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const (
+	// storage key prefix for dead letters, followed by zero padded id
+	deadLetterPrefix = "dlq-"
+	// storage key prefix for messages awaiting delayed retry, followed by zero padded id
+	retryPrefix = "retry-"
+)
+
+// DeadLetter queue message which could not be processed after all retries
+// (or failed message awaiting delayed retry, in retry store)
+type DeadLetter struct {
+	ID       uint64    `json:"id"`
+	Route    string    `json:"route"`
+	Body     []byte    `json:"body"`
+	Error    string    `json:"error"`
+	Attempts int       `json:"attempts"`
+	FailedAt time.Time `json:"failed_at"`
+	RetryAt  time.Time `json:"retry_at"`
+}
+
+func (d DeadLetter) String() string {
+	return fmt.Sprintf("id: %v, route: %v, attempts: %v, failedAt: %v, error: %v, body: %s",
+		d.ID, d.Route, d.Attempts, d.FailedAt.Format(time.RFC3339), d.Error, d.Body)
+}
+
+// DeadLetterStore persistent store for failed queue messages (stored in bridge db)
+type DeadLetterStore struct {
+	// storage client
+	storageClient *leveldb.DB
+	// storage key prefix
+	prefix string
+
+	mutex sync.Mutex
+}
+
+// NewDeadLetterStore creates dead-letter store on top of given leveldb instance
+func NewDeadLetterStore(storageClient *leveldb.DB) *DeadLetterStore {
+	return &DeadLetterStore{
+		storageClient: storageClient,
+		prefix:        deadLetterPrefix,
+	}
+}
+
+// NewRetryStore creates store for failed messages awaiting delayed retry on top of given leveldb instance
+func NewRetryStore(storageClient *leveldb.DB) *DeadLetterStore {
+	return &DeadLetterStore{
+		storageClient: storageClient,
+		prefix:        retryPrefix,
+	}
+}
+
+// OpenDeadLetterStore opens dead-letter store in bridge db
+func OpenDeadLetterStore() (*DeadLetterStore, error) {
+	storageClient := getBridgeDBInstance(viper.GetString(BridgeDBFlag))
+	if storageClient == nil {
+		return nil, fmt.Errorf("Unable to open bridge db: %v", bridgeDBErr)
+	}
+
+	return NewDeadLetterStore(storageClient), nil
+}
+
+// Add stores failed message along with failure reason
+func (s *DeadLetterStore) Add(route string, body []byte, attempts int, reason error) (*DeadLetter, error) {
+	return s.Schedule(route, body, attempts, reason, time.Time{})
+}
+
+// Schedule stores failed message along with failure reason and time it should be retried at
+func (s *DeadLetterStore) Schedule(route string, body []byte, attempts int, reason error, retryAt time.Time) (*DeadLetter, error) {
+	if s.storageClient == nil {
+		return nil, errors.New("Bridge db is not available for dead-letter store")
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	id, err := s.lastID()
+	if err != nil {
+		return nil, err
+	}
+
+	deadLetter := DeadLetter{
+		ID:       id + 1,
+		Route:    route,
+		Body:     body,
+		Attempts: attempts,
+		FailedAt: time.Now().UTC(),
+		RetryAt:  retryAt,
+	}
+	if reason != nil {
+		deadLetter.Error = reason.Error()
+	}
+
+	data, err := json.Marshal(deadLetter)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.storageClient.Put(s.key(deadLetter.ID), data, nil); err != nil {
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
+
+// Get returns dead letter by id
+func (s *DeadLetterStore) Get(id uint64) (*DeadLetter, error) {
+	data, err := s.storageClient.Get(s.key(id), nil)
+	if err == leveldb.ErrNotFound {
+		return nil, fmt.Errorf("No dead letter found with id: %v", id)
+	} else if err != nil {
+		return nil, err
+	}
+
+	var deadLetter DeadLetter
+	if err := json.Unmarshal(data, &deadLetter); err != nil {
+		return nil, err
+	}
+
+	return &deadLetter, nil
+}
+
+// List returns all dead letters, oldest first
+func (s *DeadLetterStore) List() ([]DeadLetter, error) {
+	iterator := s.storageClient.NewIterator(util.BytesPrefix([]byte(s.prefix)), nil)
+	defer iterator.Release()
+
+	deadLetters := make([]DeadLetter, 0)
+	for iterator.Next() {
+		var deadLetter DeadLetter
+		if err := json.Unmarshal(iterator.Value(), &deadLetter); err != nil {
+			return nil, err
+		}
+		deadLetters = append(deadLetters, deadLetter)
+	}
+
+	return deadLetters, iterator.Error()
+}
+
+// Remove deletes dead letter by id
+func (s *DeadLetterStore) Remove(id uint64) error {
+	if _, err := s.Get(id); err != nil {
+		return err
+	}
+
+	return s.storageClient.Delete(s.key(id), nil)
+}
+
+// Due returns dead letters which should be retried by given time, oldest first
+func (s *DeadLetterStore) Due(now time.Time) ([]DeadLetter, error) {
+	deadLetters, err := s.List()
+	if err != nil {
+		return nil, err
+	}
+
+	due := make([]DeadLetter, 0)
+	for _, deadLetter := range deadLetters {
+		if !deadLetter.RetryAt.After(now) {
+			due = append(due, deadLetter)
+		}
+	}
+
+	return due, nil
+}
+
+// Purge deletes all dead letters and returns number of deleted messages
+func (s *DeadLetterStore) Purge() (int, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	iterator := s.storageClient.NewIterator(util.BytesPrefix([]byte(s.prefix)), nil)
+	defer iterator.Release()
+
+	batch := new(leveldb.Batch)
+	for iterator.Next() {
+		batch.Delete(append([]byte{}, iterator.Key()...))
+	}
+	if err := iterator.Error(); err != nil {
+		return 0, err
+	}
+
+	return batch.Len(), s.storageClient.Write(batch, nil)
+}
+
+// Replay publishes dead letter back on its route and removes it from store
+func (s *DeadLetterStore) Replay(queue Queue, id uint64) error {
+	deadLetter, err := s.Get(id)
+	if err != nil {
+		return err
+	}
+
+	if err := queue.Publish(deadLetter.Route, deadLetter.Body); err != nil {
+		return err
+	}
+
+	return s.storageClient.Delete(s.key(id), nil)
+}
+
+// lastID returns last used dead letter id (expects lock to be held)
+func (s *DeadLetterStore) lastID() (uint64, error) {
+	iterator := s.storageClient.NewIterator(util.BytesPrefix([]byte(s.prefix)), nil)
+	defer iterator.Release()
+
+	if !iterator.Last() {
+		return 0, iterator.Error()
+	}
+
+	return strconv.ParseUint(strings.TrimPrefix(string(iterator.Key()), s.prefix), 10, 64)
+}
+
+// key returns storage key for dead letter, zero padded id keeps keys ordered
+func (s *DeadLetterStore) key(id uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", s.prefix, id))
+}
@@ -0,0 +1,193 @@
+package pier
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/stretchr/testify/require"
+)
+
+// testQueueMsg in-memory queue message
+type testQueueMsg struct {
+	body     []byte
+	acked    bool
+	rejected bool
+}
+
+func (m *testQueueMsg) Body() []byte {
+	return m.body
+}
+
+func (m *testQueueMsg) Ack() error {
+	m.acked = true
+	return nil
+}
+
+func (m *testQueueMsg) Reject() error {
+	m.rejected = true
+	return nil
+}
+
+func newTestQueueConnector(t *testing.T) *QueueConnector {
+	db := newTestQueueDB(t)
+	return &QueueConnector{
+		deadLetters: NewDeadLetterStore(db),
+		retries:     NewRetryStore(db),
+		retryPolicy: RetryPolicy{
+			MaxAttempts:    3,
+			InitialBackoff: time.Millisecond,
+			MaxBackoff:     2 * time.Millisecond,
+		},
+		quit:   make(chan struct{}),
+		logger: Logger,
+	}
+}
+
+func TestDeadLetterStore(t *testing.T) {
+	db := newTestQueueDB(t)
+	defer db.Close()
+
+	store := NewDeadLetterStore(db)
+	first, err := store.Add(heimdallBroadcastRoute, []byte("a"), 3, errors.New("failed a"))
+	require.NoError(t, err)
+	second, err := store.Add(borBroadcastRoute, []byte("b"), 1, errors.New("failed b"))
+	require.NoError(t, err)
+	require.Equal(t, first.ID+1, second.ID)
+
+	deadLetters, err := store.List()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+	require.Equal(t, heimdallBroadcastRoute, deadLetters[0].Route)
+	require.Equal(t, "a", string(deadLetters[0].Body))
+	require.Equal(t, "failed a", deadLetters[0].Error)
+	require.Equal(t, 3, deadLetters[0].Attempts)
+
+	// replay publishes on original route and removes dead letter
+	q := startTestQueue(t, db)
+	defer q.Stop()
+	require.NoError(t, store.Replay(q, second.ID))
+	require.Equal(t, 1, countRecords(t, db, borBroadcastRoute))
+	_, err = store.Get(second.ID)
+	require.Error(t, err)
+
+	count, err := store.Purge()
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+
+	deadLetters, err = store.List()
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+}
+
+func TestQueueConnectorRetry(t *testing.T) {
+	qc := newTestQueueConnector(t)
+
+	// succeeds on last attempt
+	attempts := 0
+	qc.routeHandlers = map[string]func(data []byte) error{
+		heimdallBroadcastRoute: func(data []byte) error {
+			attempts++
+			if attempts < 3 {
+				return errors.New("temporary")
+			}
+			return nil
+		},
+	}
+
+	// failed message is acked and moved to retry store, instead of blocking queue
+	msg := &testQueueMsg{body: []byte("a")}
+	qc.process(heimdallBroadcastRoute, msg)
+	require.Equal(t, 1, attempts)
+	require.True(t, msg.acked)
+
+	retries, err := qc.retries.List()
+	require.NoError(t, err)
+	require.Len(t, retries, 1)
+	require.Equal(t, 1, retries[0].Attempts)
+	require.Equal(t, "temporary", retries[0].Error)
+
+	// not retried before backoff
+	qc.retryDueMsgs(retries[0].RetryAt.Add(-time.Nanosecond))
+	require.Equal(t, 1, attempts)
+
+	qc.retryDueMsgs(time.Now().UTC().Add(time.Minute))
+	qc.retryDueMsgs(time.Now().UTC().Add(time.Minute))
+	require.Equal(t, 3, attempts)
+
+	retries, err = qc.retries.List()
+	require.NoError(t, err)
+	require.Empty(t, retries)
+
+	deadLetters, err := qc.deadLetters.List()
+	require.NoError(t, err)
+	require.Empty(t, deadLetters)
+}
+
+func TestQueueConnectorDeadLetter(t *testing.T) {
+	qc := newTestQueueConnector(t)
+
+	// fails on every attempt, invalid message is not retried
+	attempts := 0
+	qc.routeHandlers = map[string]func(data []byte) error{
+		heimdallBroadcastRoute: func(data []byte) error {
+			attempts++
+			return errors.New("rest server down")
+		},
+		borBroadcastRoute: func(data []byte) error {
+			return permanentError{errors.New("invalid")}
+		},
+	}
+
+	msg := &testQueueMsg{body: []byte("a")}
+	qc.process(heimdallBroadcastRoute, msg)
+	for i := 0; i < 3; i++ {
+		qc.retryDueMsgs(time.Now().UTC().Add(time.Minute))
+	}
+	require.Equal(t, 3, attempts)
+
+	invalid := &testQueueMsg{body: []byte("b")}
+	qc.process(borBroadcastRoute, invalid)
+
+	require.True(t, msg.acked)
+	require.True(t, invalid.acked)
+	require.False(t, msg.rejected || invalid.rejected)
+
+	retries, err := qc.retries.List()
+	require.NoError(t, err)
+	require.Empty(t, retries)
+
+	deadLetters, err := qc.deadLetters.List()
+	require.NoError(t, err)
+	require.Len(t, deadLetters, 2)
+	require.Equal(t, "rest server down", deadLetters[0].Error)
+	require.Equal(t, 3, deadLetters[0].Attempts)
+	require.Equal(t, borBroadcastRoute, deadLetters[1].Route)
+	require.Equal(t, 1, deadLetters[1].Attempts)
+}
+
+func TestQueueConnectorDryRun(t *testing.T) {
+	db := newTestQueueDB(t)
+	defer db.Close()
+
+	q := startTestQueue(t, db)
+	defer q.Stop()
+
+	qc := newTestQueueConnector(t)
+	qc.queue = q
+	qc.dryRun = true
+
+	// nothing is published in dry-run mode
+	to := ethCommon.HexToAddress("0x01")
+	data, err := json.Marshal(ethereum.CallMsg{To: &to, Data: []byte{1}})
+	require.NoError(t, err)
+	require.NoError(t, qc.BroadcastToBor(data))
+	require.Equal(t, 0, countRecords(t, db, borBroadcastRoute))
+
+	qc.dryRun = false
+	require.NoError(t, qc.BroadcastToBor(data))
+	require.Equal(t, 1, countRecords(t, db, borBroadcastRoute))
+}
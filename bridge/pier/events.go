@@ -0,0 +1,438 @@
+package pier
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi"
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/contracts/rootchain"
+	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/helper"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// EventContext is passed to event handlers along with log of the event
+type EventContext struct {
+	CLIContext     cliContext.CLIContext
+	HeimdallClient *heimdallclient.Client
+	Logger         log.Logger
+
+	// ABI and name of the event
+	ABI       *abi.ABI
+	EventName string
+}
+
+// Unpack unpacks log data into event object
+func (ctx EventContext) Unpack(event interface{}, vLog *types.Log) error {
+	return helper.UnpackLog(ctx.ABI, event, ctx.EventName, vLog)
+}
+
+// EventHandler returns heimdall msgs to broadcast for log of registered event
+// (none if event doesn't concern this validator)
+type EventHandler func(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error)
+
+// registeredEvent event registered for contract address
+type registeredEvent struct {
+	abi     *abi.ABI
+	name    string
+	handler EventHandler
+}
+
+// eventKey identifies event by contract address and event id (first topic)
+type eventKey struct {
+	address ethCommon.Address
+	id      ethCommon.Hash
+}
+
+// EventRegistry keeps mainchain contract events processed by syncer.
+// Syncer queries logs of all registered contracts and dispatches each log to handler of its event.
+type EventRegistry struct {
+	events    map[eventKey]registeredEvent
+	addresses []ethCommon.Address
+}
+
+// NewEventRegistry creates empty event registry
+func NewEventRegistry() *EventRegistry {
+	return &EventRegistry{
+		events: make(map[eventKey]registeredEvent),
+	}
+}
+
+// Register registers handler for event of contract at given address
+func (r *EventRegistry) Register(address ethCommon.Address, abiObject *abi.ABI, eventName string, handler EventHandler) error {
+	event, ok := abiObject.Events[eventName]
+	if !ok {
+		return fmt.Errorf("Event %v not found in contract ABI", eventName)
+	}
+
+	key := eventKey{address: address, id: event.Id()}
+	if _, ok := r.events[key]; ok {
+		return fmt.Errorf("Event %v is already registered for contract %v", eventName, address.Hex())
+	}
+
+	if !r.hasAddress(address) {
+		r.addresses = append(r.addresses, address)
+	}
+
+	r.events[key] = registeredEvent{
+		abi:     abiObject,
+		name:    eventName,
+		handler: handler,
+	}
+	return nil
+}
+
+// Addresses returns addresses of contracts with registered events
+func (r *EventRegistry) Addresses() []ethCommon.Address {
+	return r.addresses
+}
+
+// lookup returns registered event of given log
+func (r *EventRegistry) lookup(vLog *types.Log) (registeredEvent, bool) {
+	if len(vLog.Topics) == 0 {
+		return registeredEvent{}, false
+	}
+
+	event, ok := r.events[eventKey{address: vLog.Address, id: vLog.Topics[0]}]
+	return event, ok
+}
+
+func (r *EventRegistry) hasAddress(address ethCommon.Address) bool {
+	for _, a := range r.addresses {
+		if a == address {
+			return true
+		}
+	}
+	return false
+}
+
+// registerDefaultEvents registers events of root chain, staking info and state sender contracts
+func registerDefaultEvents(r *EventRegistry, contractCaller helper.ContractCaller) error {
+	registrations := []struct {
+		address   ethCommon.Address
+		abiObject *abi.ABI
+		eventName string
+		handler   EventHandler
+	}{
+		{helper.GetRootChainAddress(), &contractCaller.RootChainABI, headerEvent, handleCheckpointEvent},
+		{helper.GetStakingInfoAddress(), &contractCaller.StakingInfoABI, unstakeInitEvent, handleUnstakeInitEvent},
+		{helper.GetStakingInfoAddress(), &contractCaller.StakingInfoABI, stakeUpdateEvent, handleStakeUpdateEvent},
+		{helper.GetStakingInfoAddress(), &contractCaller.StakingInfoABI, signerChange, handleSignerChangeEvent},
+		{helper.GetStakingInfoAddress(), &contractCaller.StakingInfoABI, reStakedEvent, handleReStakedEvent},
+		{helper.GetStakingInfoAddress(), &contractCaller.StakingInfoABI, jailedEvent, handleJailedEvent},
+		{helper.GetStakingInfoAddress(), &contractCaller.StakingInfoABI, shareMintedEvent, handleShareMintedEvent},
+		{helper.GetStakingInfoAddress(), &contractCaller.StakingInfoABI, shareBurnedEvent, handleShareBurnedEvent},
+		{helper.GetStakingInfoAddress(), &contractCaller.StakingInfoABI, topupFeeEvent, handleTopupFeeEvent},
+		{helper.GetStateSenderAddress(), &contractCaller.StateSenderABI, stateSyncedEvent, handleStateSyncedEvent},
+	}
+
+	for _, registration := range registrations {
+		if err := r.Register(registration.address, registration.abiObject, registration.eventName, registration.handler); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+//
+// Default event handlers
+//
+
+func handleCheckpointEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(rootchain.RootchainNewHeaderBlock)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Info(
+		"⬜ New event found",
+		"event", ctx.EventName,
+		"start", event.Start,
+		"end", event.End,
+		"reward", event.Reward,
+		"root", "0x"+hex.EncodeToString(event.Root[:]),
+		"proposer", event.Proposer.Hex(),
+		"headerNumber", event.HeaderBlockId,
+	)
+
+	// create msg checkpoint ack message
+	msg := checkpointTypes.NewMsgCheckpointAck(
+		helper.GetFromAddress(ctx.CLIContext),
+		event.HeaderBlockId.Uint64(),
+		hmTypes.BytesToHeimdallAddress(event.Proposer.Bytes()),
+		event.Start.Uint64(),
+		event.End.Uint64(),
+		hmTypes.BytesToHeimdallHash(event.Root[:]),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+func handleUnstakeInitEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(stakinginfo.StakinginfoUnstakeInit)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Debug(
+		"⬜ New event found",
+		"event", ctx.EventName,
+		"validator", event.User,
+		"validatorID", event.ValidatorId,
+		"deactivatonEpoch", event.DeactivationEpoch,
+		"amount", event.Amount,
+	)
+
+	// msg validator exit
+	if !isEventSender(ctx.HeimdallClient, event.ValidatorId.Uint64()) {
+		return nil, nil
+	}
+
+	msg := stakingTypes.NewMsgValidatorExit(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		event.ValidatorId.Uint64(),
+		event.DeactivationEpoch.Uint64(),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+func handleStakeUpdateEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(stakinginfo.StakinginfoStakeUpdate)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Debug(
+		"⬜ New event found",
+		"event", ctx.EventName,
+		"validatorID", event.ValidatorId,
+		"newAmount", event.NewAmount,
+	)
+
+	// msg stake update
+	if !isEventSender(ctx.HeimdallClient, event.ValidatorId.Uint64()) {
+		return nil, nil
+	}
+
+	msg := stakingTypes.NewMsgStakeUpdate(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		event.ValidatorId.Uint64(),
+		hmTypes.NewIntFromBigInt(event.NewAmount),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+func handleSignerChangeEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(stakinginfo.StakinginfoSignerChange)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Debug(
+		"⬜ New event found",
+		"event", ctx.EventName,
+		"validatorID", event.ValidatorId,
+		"newSigner", event.NewSigner.Hex(),
+		"oldSigner", event.OldSigner.Hex(),
+	)
+
+	// signer change
+	if !bytes.Equal(event.NewSigner.Bytes(), helper.GetAddress()) {
+		return nil, nil
+	}
+
+	pubkey := helper.GetPubKey()
+	msg := stakingTypes.NewMsgSignerUpdate(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		event.ValidatorId.Uint64(),
+		hmTypes.NewPubKey(pubkey[:]),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+func handleReStakedEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(stakinginfo.StakinginfoReStaked)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Debug(
+		"⬜ New event found",
+		"event", ctx.EventName,
+		"validatorId", event.ValidatorId,
+		"amount", event.Amount,
+	)
+
+	// msg validator restake
+	if !isEventSender(ctx.HeimdallClient, event.ValidatorId.Uint64()) {
+		return nil, nil
+	}
+
+	msg := stakingTypes.NewMsgValidatorReStake(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		event.ValidatorId.Uint64(),
+		hmTypes.NewIntFromBigInt(event.Total),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+func handleJailedEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(stakinginfo.StakinginfoJailed)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Debug(
+		"⬜ New event found",
+		"event", ctx.EventName,
+		"validatorID", event.ValidatorId,
+		"exitEpoch", event.ExitEpoch,
+	)
+
+	// every validator reports jail, jailed validator has no incentive to do it
+	msg := stakingTypes.NewMsgValidatorJailed(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		event.ValidatorId.Uint64(),
+		event.ExitEpoch.Uint64(),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+func handleShareMintedEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(stakinginfo.StakinginfoShareMinted)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Debug(
+		"⬜ New event found",
+		"event", ctx.EventName,
+		"validatorID", event.ValidatorId,
+		"user", event.User.Hex(),
+		"shares", event.Amount,
+		"tokens", event.Tokens,
+	)
+
+	msg := stakingTypes.NewMsgShareMint(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		event.ValidatorId.Uint64(),
+		hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+		hmTypes.NewIntFromBigInt(event.Amount),
+		hmTypes.NewIntFromBigInt(event.Tokens),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+func handleShareBurnedEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(stakinginfo.StakinginfoShareBurned)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Debug(
+		"⬜ New event found",
+		"event", ctx.EventName,
+		"validatorID", event.ValidatorId,
+		"user", event.User.Hex(),
+		"shares", event.Amount,
+		"tokens", event.Tokens,
+	)
+
+	msg := stakingTypes.NewMsgShareBurn(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		event.ValidatorId.Uint64(),
+		hmTypes.BytesToHeimdallAddress(event.User.Bytes()),
+		hmTypes.NewIntFromBigInt(event.Amount),
+		hmTypes.NewIntFromBigInt(event.Tokens),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+func handleStateSyncedEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(statesender.StatesenderStateSynced)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Debug(
+		"⬜ New event found",
+		"event", ctx.EventName,
+		"id", event.Id,
+		"contract", event.ContractAddress,
+		"data", hex.EncodeToString(event.Data),
+		"borChainId", helper.GetConfig().BorChainID,
+	)
+
+	// create clerk event record
+	msg := clerkTypes.NewMsgEventRecord(
+		hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		event.Id.Uint64(),
+		hmTypes.BytesToHeimdallAddress(event.ContractAddress.Bytes()),
+		hmTypes.BytesToHexBytes(event.Data),
+		helper.GetConfig().BorChainID,
+	)
+	return []sdk.Msg{msg}, nil
+}
+
+func handleTopupFeeEvent(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+	event := new(stakinginfo.StakinginfoTopUpFee)
+	if err := ctx.Unpack(event, vLog); err != nil {
+		return nil, err
+	}
+
+	ctx.Logger.Info(
+		"New event found",
+		"event", ctx.EventName,
+		"validatorId", event.ValidatorId,
+		"Fee", event.Fee,
+	)
+
+	// create msg topup message
+	msg := topupTypes.NewMsgTopup(
+		helper.GetFromAddress(ctx.CLIContext),
+		event.ValidatorId.Uint64(),
+		hmTypes.BytesToHeimdallAddress(event.Signer.Bytes()),
+		hmTypes.NewIntFromBigInt(event.Fee),
+		hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
+		uint64(vLog.Index),
+		vLog.BlockNumber,
+	)
+	return []sdk.Msg{msg}, nil
+}
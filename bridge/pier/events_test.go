@@ -0,0 +1,49 @@
+package pier
+
+import (
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi"
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/stretchr/testify/require"
+)
+
+const testEventsABI = `[
+	{"type":"event","name":"Ping","anonymous":false,"inputs":[{"name":"id","type":"uint256","indexed":true}]},
+	{"type":"event","name":"Pong","anonymous":false,"inputs":[{"name":"id","type":"uint256","indexed":true}]}
+]`
+
+func TestEventRegistry(t *testing.T) {
+	abiObject, err := abi.JSON(strings.NewReader(testEventsABI))
+	require.NoError(t, err)
+
+	first := ethCommon.HexToAddress("0x01")
+	second := ethCommon.HexToAddress("0x02")
+	handler := func(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+		return nil, nil
+	}
+
+	registry := NewEventRegistry()
+	require.NoError(t, registry.Register(first, &abiObject, "Ping", handler))
+	require.NoError(t, registry.Register(first, &abiObject, "Pong", handler))
+	require.NoError(t, registry.Register(second, &abiObject, "Ping", handler))
+	require.Equal(t, []ethCommon.Address{first, second}, registry.Addresses())
+
+	// unknown and duplicate events are rejected
+	require.Error(t, registry.Register(first, &abiObject, "Unknown", handler))
+	require.Error(t, registry.Register(first, &abiObject, "Ping", handler))
+
+	// logs are matched by contract address and event id
+	event, ok := registry.lookup(&types.Log{Address: first, Topics: []ethCommon.Hash{abiObject.Events["Pong"].Id()}})
+	require.True(t, ok)
+	require.Equal(t, "Pong", event.name)
+
+	_, ok = registry.lookup(&types.Log{Address: second, Topics: []ethCommon.Hash{abiObject.Events["Pong"].Id()}})
+	require.False(t, ok)
+
+	_, ok = registry.lookup(&types.Log{Address: first})
+	require.False(t, ok)
+}
@@ -0,0 +1,226 @@
+package pier
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/tendermint/tendermint/libs/common"
+)
+
+const (
+	metricsNamespace = "bridge"
+
+	// queue message actions
+	queueActionPublish    = "publish"
+	queueActionConsume    = "consume"
+	queueActionAck        = "ack"
+	queueActionReject     = "reject"
+	queueActionRetry      = "retry"
+	queueActionDeadLetter = "dead_letter"
+
+	// checkpoint submission results
+	checkpointSubmissionSuccess = "success"
+	checkpointSubmissionFailure = "failure"
+
+	// dry-run targets
+	dryRunTargetHeimdall  = "heimdall"
+	dryRunTargetBor       = "bor"
+	dryRunTargetMainchain = "mainchain"
+)
+
+// MetricsRegistry registry for all bridge metrics
+var MetricsRegistry = prometheus.NewRegistry()
+
+var (
+	queueMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "queue",
+		Name:      "messages_total",
+		Help:      "Number of queue messages per route and action (publish, consume, ack, reject, dead_letter).",
+	}, []string{"route", "action"})
+
+	lastProcessedBlock = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "syncer",
+		Name:      "last_processed_block",
+		Help:      "Last mainchain block processed by syncer.",
+	})
+
+	lastClerkRecordID = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "clerk",
+		Name:      "last_record_id",
+		Help:      "Last clerk event record id processed by clerk service.",
+	})
+
+	checkpointSubmissions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "checkpoint",
+		Name:      "submissions_total",
+		Help:      "Number of checkpoint submissions to mainchain by result (success, failure).",
+	}, []string{"result"})
+
+	dryRunActions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "dry_run",
+		Name:      "actions_total",
+		Help:      "Number of actions skipped in dry-run mode per target chain (heimdall, bor, mainchain) and type.",
+	}, []string{"target", "type"})
+
+	noAcksSent = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "checkpoint",
+		Name:      "no_acks_sent_total",
+		Help:      "Number of no-ack messages sent to heimdall.",
+	})
+
+	rpcDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "rpc",
+		Name:      "request_duration_seconds",
+		Help:      "Latency of rpc/rest requests per endpoint.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"endpoint"})
+
+	rpcErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: "rpc",
+		Name:      "errors_total",
+		Help:      "Number of failed rpc/rest requests per endpoint.",
+	}, []string{"endpoint"})
+)
+
+func init() {
+	MetricsRegistry.MustRegister(
+		queueMessages,
+		lastProcessedBlock,
+		lastClerkRecordID,
+		checkpointSubmissions,
+		noAcksSent,
+		dryRunActions,
+		rpcDuration,
+		rpcErrors,
+	)
+}
+
+//
+// RPC metrics
+//
+
+// metricsTransport http transport which records latency and errors of each request per endpoint (host)
+type metricsTransport struct {
+	transport http.RoundTripper
+}
+
+// NewMetricsTransport wraps given transport (default transport if nil) with rpc metrics
+func NewMetricsTransport(transport http.RoundTripper) http.RoundTripper {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return &metricsTransport{transport: transport}
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *metricsTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	endpoint := req.URL.Host
+	start := time.Now()
+
+	resp, err := t.transport.RoundTrip(req)
+	rpcDuration.WithLabelValues(endpoint).Observe(time.Since(start).Seconds())
+	if err != nil || resp.StatusCode >= http.StatusInternalServerError {
+		rpcErrors.WithLabelValues(endpoint).Inc()
+	}
+
+	return resp, err
+}
+
+//
+// Queue and service collectors
+//
+
+// queueDepthCollector reports number of pending messages of each route on scrape
+type queueDepthCollector struct {
+	queue Queue
+	desc  *prometheus.Desc
+}
+
+func (c *queueDepthCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *queueDepthCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, route := range []string{heimdallBroadcastRoute, borBroadcastRoute} {
+		depth, err := c.queue.Depth(route)
+		if err != nil {
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, float64(depth), route)
+	}
+}
+
+// serviceStatusCollector reports up/down status of each bridge service on scrape
+type serviceStatusCollector struct {
+	services []common.Service
+	desc     *prometheus.Desc
+}
+
+func (c *serviceStatusCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.desc
+}
+
+func (c *serviceStatusCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, service := range c.services {
+		up := 0.0
+		if service.IsRunning() {
+			up = 1
+		}
+		ch <- prometheus.MustNewConstMetric(c.desc, prometheus.GaugeValue, up, service.String())
+	}
+}
+
+// NewMetricsHandler registers queue and service metrics, and returns handler serving `/metrics` and `/health`
+func NewMetricsHandler(queue Queue, services []common.Service) http.Handler {
+	MetricsRegistry.MustRegister(
+		&queueDepthCollector{
+			queue: queue,
+			desc: prometheus.NewDesc(
+				prometheus.BuildFQName(metricsNamespace, "queue", "depth"),
+				"Number of pending messages per route.",
+				[]string{"route"}, nil,
+			),
+		},
+		&serviceStatusCollector{
+			services: services,
+			desc: prometheus.NewDesc(
+				prometheus.BuildFQName(metricsNamespace, "service", "up"),
+				"Whether bridge service is running (1) or not (0).",
+				[]string{"service"}, nil,
+			),
+		},
+	)
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", promhttp.HandlerFor(MetricsRegistry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/health", func(w http.ResponseWriter, r *http.Request) {
+		healthy := true
+		statuses := make(map[string]bool)
+		for _, service := range services {
+			statuses[service.String()] = service.IsRunning()
+			healthy = healthy && service.IsRunning()
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if !healthy {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"healthy":  healthy,
+			"services": statuses,
+		})
+	})
+
+	return mux
+}
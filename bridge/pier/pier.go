@@ -8,6 +8,7 @@ import (
 )
 
 var bridgeDB *leveldb.DB
+var bridgeDBErr error
 var bridgeDBOnce sync.Once
 var bridgeDBCloseOnce sync.Once
 
@@ -21,14 +22,18 @@ func init() {
 // GetBridgeDBInstance get sington object for bridge-db
 func getBridgeDBInstance(filePath string) *leveldb.DB {
 	bridgeDBOnce.Do(func() {
-		bridgeDB, _ = leveldb.OpenFile(filePath, nil)
+		bridgeDB, bridgeDBErr = leveldb.OpenFile(filePath, nil)
+		if bridgeDBErr != nil {
+			pierLogger.Error("Error while opening bridge db", "path", filePath, "error", bridgeDBErr)
+		}
 	})
 
 	return bridgeDB
 }
 
-// CloseBridgeDBInstance closes bridge-db instance
-func closeBridgeDBInstance() {
+// CloseBridgeDBInstance closes bridge-db instance.
+// Services and queue share the instance, so it must be closed only after all of them have stopped.
+func CloseBridgeDBInstance() {
 	bridgeDBCloseOnce.Do(func() {
 		if bridgeDB != nil {
 			bridgeDB.Close()
@@ -1,46 +1,139 @@
 package pier
 
 import (
-	"context"
+	"encoding/hex"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	cliContext "github.com/cosmos/cosmos-sdk/client/context"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	ethereum "github.com/maticnetwork/bor"
-	"github.com/maticnetwork/bor/core/types"
-	"github.com/streadway/amqp"
+	"github.com/spf13/viper"
 	"github.com/tendermint/tendermint/libs/log"
 
-	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	"github.com/maticnetwork/heimdall/helper"
-	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 const (
 	connector = "queue-connector"
 
-	// exchanges
-	broadcastExchange = "bridge.exchange.broadcast"
-	// heimdall queue
-	heimdallBroadcastQueue = "bridge.queue.heimdall"
-	// bor queue
-	borBroadcastQueue = "bridge.queue.bor"
-
 	// heimdall routing key
 	heimdallBroadcastRoute = "bridge.route.heimdall"
 	// bor routing key
 	borBroadcastRoute = "bridge.route.bor"
 )
 
+// RetryPolicy exponential backoff policy for queue messages which failed to process.
+// Failed messages are acked and moved to retry store, so they don't block the queue.
+type RetryPolicy struct {
+	// max number of attempts before message is moved to dead-letter store
+	MaxAttempts int
+	// backoff before first retry, doubled after every attempt
+	InitialBackoff time.Duration
+	// upper limit for backoff
+	MaxBackoff time.Duration
+}
+
+// DefaultRetryPolicy default retry policy for queue connector
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    6,
+	InitialBackoff: 2 * time.Second,
+	MaxBackoff:     1 * time.Minute,
+}
+
+// Backoff returns delay before retrying message which failed given number of attempts
+func (p RetryPolicy) Backoff(attempts int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempts && backoff < p.MaxBackoff; i++ {
+		backoff = backoff * 2
+	}
+
+	if backoff > p.MaxBackoff {
+		backoff = p.MaxBackoff
+	}
+	return backoff
+}
+
+// permanentError error which can't be fixed by retrying (eg. invalid message)
+type permanentError struct {
+	err error
+}
+
+func (e permanentError) Error() string {
+	return e.err.Error()
+}
+
+// QueueMsg represents single message delivered by queue backend
+type QueueMsg interface {
+	// Body returns message payload
+	Body() []byte
+	// Ack acknowledges message and removes it from queue
+	Ack() error
+	// Reject drops message from queue without processing it
+	Reject() error
+}
+
+// Queue represents queue backend used by bridge to publish and consume messages
+type Queue interface {
+	// Start prepares routes for publishing and consuming
+	Start() error
+	// Stop stops message delivery, publishing keeps working
+	Stop()
+	// Close stops message delivery and closes connection (if any)
+	Close()
+	// Publish publishes data on given route
+	Publish(route string, data []byte) error
+	// Consume returns channel which delivers messages published on given route
+	Consume(route string) (<-chan QueueMsg, error)
+	// Depth returns number of messages pending on given route
+	Depth(route string) (int, error)
+}
+
+// NewQueue creates queue backend from heimdall config
+func NewQueue(config helper.Configuration) (Queue, error) {
+	switch config.QueueBackend {
+	case helper.LevelDBQueueBackend:
+		storageClient := getBridgeDBInstance(viper.GetString(BridgeDBFlag))
+		if storageClient == nil {
+			return nil, fmt.Errorf("Unable to open bridge db for queue: %v", bridgeDBErr)
+		}
+		return NewLevelDBQueue(storageClient), nil
+	case helper.AMQPQueueBackend, "":
+		return NewAMQPQueue(config.AmqpURL)
+	default:
+		return nil, fmt.Errorf("Invalid queue backend: %v", config.QueueBackend)
+	}
+}
+
 // QueueConnector queue connector
 type QueueConnector struct {
-	// URL for connecting to AMQP
-	connection *amqp.Connection
-	// create a channel
-	channel *amqp.Channel
+	// queue backend
+	queue Queue
+	// failed messages store
+	deadLetters *DeadLetterStore
+	// failed messages awaiting delayed retry
+	retries *DeadLetterStore
+	// message handlers by route
+	routeHandlers map[string]func(data []byte) error
+	// retry policy for failed messages
+	retryPolicy RetryPolicy
+	// account sequence manager for heimdall broadcasts
+	sequenceManager *SequenceManager
+	// tx manager for bor txs
+	borTxManager *helper.TxManager
+	// messages are only logged, not published or consumed
+	dryRun bool
+	// closed on stop
+	quit chan struct{}
+	// closed once message delivery stops while connector is running
+	deliveryStopped chan struct{}
+	// tracks message handlers, so stop waits for in-flight messages
+	handlers sync.WaitGroup
 	// tx encoder
 	cliCtx cliContext.CLIContext
 	// logger
@@ -48,30 +141,45 @@ type QueueConnector struct {
 }
 
 // NewQueueConnector creates a connector object which can be used to connect/send/consume bytes from queue
-func NewQueueConnector(cdc *codec.Codec, dialer string) *QueueConnector {
+func NewQueueConnector(cdc *codec.Codec, queue Queue) *QueueConnector {
+	// heimdall txs are broadcast in sync mode by sequence manager
 	cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
-	cliCtx.BroadcastMode = client.BroadcastAsync
+	cliCtx.BroadcastMode = client.BroadcastSync
 	cliCtx.TrustNode = true
 
-	// amqp dialer
-	conn, err := amqp.Dial(dialer)
+	// create logger
+	logger := Logger.With("module", "queue-connector")
+
+	// storage client
+	storageClient := getBridgeDBInstance(viper.GetString(BridgeDBFlag))
+
+	// tx manager for bor txs (connector can still publish without it)
+	borTxManager, err := helper.NewTxManager("bor", helper.GetMaticClient(), storageClient)
 	if err != nil {
-		panic(err)
+		logger.Error("Error while creating bor tx manager", "error", err)
 	}
 
-	// initialize exchange
-	channel, err := conn.Channel()
-	if err != nil {
-		panic(err)
+	// dry-run mode
+	dryRun := viper.GetBool(DryRunFlag)
+	if dryRun {
+		logger.Info("Dry run enabled, messages are logged instead of being published")
 	}
 
 	// queue connector
 	connector := QueueConnector{
-		connection: conn,
-		channel:    channel,
-		cliCtx:     cliCtx,
-		// create logger
-		logger: Logger.With("module", "queue-connector"),
+		queue:           queue,
+		deadLetters:     NewDeadLetterStore(storageClient),
+		retries:         NewRetryStore(storageClient),
+		retryPolicy:     DefaultRetryPolicy,
+		sequenceManager: NewSequenceManager(cliCtx),
+		borTxManager:    borTxManager,
+		dryRun:          dryRun,
+		cliCtx:          cliCtx,
+		logger:          logger,
+	}
+	connector.routeHandlers = map[string]func(data []byte) error{
+		heimdallBroadcastRoute: connector.handleHeimdallBroadcastMsg,
+		borBroadcastRoute:      connector.handleBorBroadcastMsg,
 	}
 
 	// connector
@@ -80,110 +188,101 @@ func NewQueueConnector(cdc *codec.Codec, dialer string) *QueueConnector {
 
 // Start connector
 func (qc *QueueConnector) Start() error {
-	// exchange declare
-	if err := qc.channel.ExchangeDeclare(
-		broadcastExchange, // name
-		"topic",           // type
-		true,              // durable
-		false,             // auto-deleted
-		false,             // internal
-		false,             // no-wait
-		nil,               // arguments
-	); err != nil {
-		return err
+	// nothing is published in dry-run mode, messages left by previous runs are not sent either
+	if qc.dryRun {
+		qc.logger.Info("Dry run, not consuming queue")
+		return nil
 	}
 
-	// Heimdall
-
-	// queue declare
-	if _, err := qc.channel.QueueDeclare(
-		heimdallBroadcastQueue, // name
-		true,                   // durable
-		false,                  // delete when usused
-		false,                  // exclusive
-		false,                  // no-wait
-		nil,                    // arguments
-	); err != nil {
-		return err
+	if qc.borTxManager == nil {
+		return errors.New("Bor tx manager is not available, unable to consume bor messages")
 	}
 
-	// bind queue
-	if err := qc.channel.QueueBind(
-		heimdallBroadcastQueue, // queue name
-		heimdallBroadcastRoute, // routing key
-		broadcastExchange,      // exchange
-		false,
-		nil,
-	); err != nil {
-		return err
+	quit := make(chan struct{})
+	deliveryStopped := make(chan struct{})
+	var once sync.Once
+	qc.quit = quit
+	qc.deliveryStopped = deliveryStopped
+
+	// reports delivery which stopped while connector is running
+	onDeliveryClosed := func(route string) {
+		select {
+		case <-quit:
+		default:
+			qc.logger.Error("Queue delivery stopped unexpectedly", "route", route)
+			once.Do(func() { close(deliveryStopped) })
+		}
 	}
 
-	// start consuming
-	msgs, err := qc.channel.Consume(
-		heimdallBroadcastQueue, // queue
-		heimdallBroadcastQueue, // consumer  -- consumer identifier
-		false,                  // auto-ack
-		false,                  // exclusive
-		false,                  // no-local
-		false,                  // no-wait
-		nil,                    // args
-	)
-	if err != nil {
-		return err
-	}
-	// process heimdall broadcast messages
-	go qc.handleHeimdallBroadcastMsgs(msgs)
-
-	// Bor
-
-	// queue declare
-	if _, err := qc.channel.QueueDeclare(
-		borBroadcastQueue, // name
-		true,              // durable
-		false,             // delete when usused
-		false,             // exclusive
-		false,             // no-wait
-		nil,               // arguments
-	); err != nil {
+	// start queue
+	if err := qc.queue.Start(); err != nil {
 		return err
 	}
 
-	// bind queue
-	if err := qc.channel.QueueBind(
-		borBroadcastQueue, // queue name
-		borBroadcastRoute, // routing key
-		broadcastExchange, // exchange
-		false,
-		nil,
-	); err != nil {
+	// start consuming heimdall messages
+	heimdallMsgs, err := qc.queue.Consume(heimdallBroadcastRoute)
+	if err != nil {
 		return err
 	}
 
-	// start consuming
-	msgs, err = qc.channel.Consume(
-		borBroadcastQueue, // queue
-		borBroadcastQueue, // consumer  -- consumer identifier
-		false,             // auto-ack
-		false,             // exclusive
-		false,             // no-local
-		false,             // no-wait
-		nil,               // args
-	)
+	// start consuming bor messages
+	borMsgs, err := qc.queue.Consume(borBroadcastRoute)
 	if err != nil {
 		return err
 	}
 
+	// process heimdall broadcast messages
+	qc.handlers.Add(1)
+	go func() {
+		qc.consume(heimdallBroadcastRoute, heimdallMsgs)
+		onDeliveryClosed(heimdallBroadcastRoute)
+	}()
+
 	// process bor broadcast messages
-	go qc.handleBorBroadcastMsgs(msgs)
+	qc.handlers.Add(1)
+	go func() {
+		qc.consume(borBroadcastRoute, borMsgs)
+		onDeliveryClosed(borBroadcastRoute)
+	}()
+
+	// retry failed messages once they are due
+	qc.handlers.Add(1)
+	go qc.retryFailedMsgs(quit)
+
+	// start tracking pending bor txs
+	qc.borTxManager.Start()
 
 	return nil
 }
 
-// Stop connector
+// DeliveryStopped returns channel which is closed once message delivery stops while connector is
+// running (eg. broker closed connection), so consumer can be restarted.
+func (qc *QueueConnector) DeliveryStopped() <-chan struct{} {
+	return qc.deliveryStopped
+}
+
+// Stop connector. It waits for in-flight messages to be processed, so no message is half processed
+// on shutdown. Messages awaiting retry stay in retry store and are retried after restart.
 func (qc *QueueConnector) Stop() {
-	// close channel & connection
-	qc.channel.Close()
-	qc.connection.Close()
+	if qc.dryRun {
+		return
+	}
+
+	if qc.quit != nil {
+		select {
+		case <-qc.quit:
+		default:
+			close(qc.quit)
+		}
+	}
+
+	// stop delivery and wait for handlers, publishing keeps working for other services
+	qc.queue.Stop()
+	qc.handlers.Wait()
+
+	if qc.borTxManager != nil {
+		qc.borTxManager.Stop()
+	}
 }
 
 //
@@ -202,149 +301,198 @@ func (qc *QueueConnector) BroadcastToHeimdall(msg sdk.Msg) error {
 
 // BroadcastBytesToHeimdall broadcasts bytes to heimdall
 func (qc *QueueConnector) BroadcastBytesToHeimdall(data []byte) error {
-	if err := qc.channel.Publish(
-		broadcastExchange,      // exchange
-		heimdallBroadcastRoute, // routing key
-		false,                  // mandatory
-		false,                  // immediate
-		amqp.Publishing{
-			ContentType: "text/plain",
-			Body:        data,
-		}); err != nil {
-		return err
-	}
-
-	return nil
+	return qc.publish(heimdallBroadcastRoute, data)
 }
 
 // BroadcastToBor broadcasts to bor
 func (qc *QueueConnector) BroadcastToBor(data []byte) error {
-	if err := qc.channel.Publish(
-		broadcastExchange, // exchange
-		borBroadcastRoute, // routing key
-		false,             // mandatory
-		false,             // immediate
-		amqp.Publishing{
-			ContentType: "text/plain",
-			Body:        data,
-		}); err != nil {
+	return qc.publish(borBroadcastRoute, data)
+}
+
+// publish publishes data on route and records it
+func (qc *QueueConnector) publish(route string, data []byte) error {
+	if qc.dryRun {
+		qc.logDryRun(route, data)
+		return nil
+	}
+
+	if err := qc.queue.Publish(route, data); err != nil {
 		return err
 	}
 
+	queueMessages.WithLabelValues(route, queueActionPublish).Inc()
 	return nil
 }
 
+// logDryRun logs and records message which would have been published on route
+func (qc *QueueConnector) logDryRun(route string, data []byte) {
+	switch route {
+	case heimdallBroadcastRoute:
+		var msg sdk.Msg
+		if err := qc.cliCtx.Codec.UnmarshalJSON(data, &msg); err != nil {
+			qc.logger.Error("Dry run, unable to decode heimdall msg", "data", string(data), "error", err)
+			return
+		}
+
+		dryRunActions.WithLabelValues(dryRunTargetHeimdall, msg.Type()).Inc()
+		qc.logger.Info("Dry run, heimdall msg not broadcast", "type", msg.Type(), "msg", string(data))
+	case borBroadcastRoute:
+		var msg ethereum.CallMsg
+		if err := json.Unmarshal(data, &msg); err != nil || msg.To == nil {
+			qc.logger.Error("Dry run, unable to decode bor tx", "data", string(data), "error", err)
+			return
+		}
+
+		dryRunActions.WithLabelValues(dryRunTargetBor, "tx").Inc()
+		qc.logger.Info("Dry run, bor tx not sent", "to", msg.To.Hex(), "value", msg.Value, "data", hex.EncodeToString(msg.Data))
+	}
+}
+
 //
 // Consume
 //
 
-func (qc *QueueConnector) handleHeimdallBroadcastMsgs(amqpMsgs <-chan amqp.Delivery) {
-	// tx encoder
-	txEncoder := helper.GetTxEncoder(qc.cliCtx.Codec)
-	// chain id
-	chainID := helper.GetGenesisDoc().ChainID
-	// current address
-	address := hmTypes.BytesToHeimdallAddress(helper.GetAddress())
-	// fetch from APIs
-	var account authTypes.Account
-	response, err := FetchFromAPI(qc.cliCtx, GetHeimdallServerEndpoint(fmt.Sprintf(AccountDetailsURL, address)))
-	if err != nil {
-		qc.logger.Error("Error fetching account from rest-api", "url", GetHeimdallServerEndpoint(fmt.Sprintf(AccountDetailsURL, address)))
-		panic("Error connecting to rest-server, please start server before bridge")
-	}
+// consume processes messages delivered on route until delivery stops
+func (qc *QueueConnector) consume(route string, queueMsgs <-chan QueueMsg) {
+	defer qc.handlers.Done()
 
-	// get proposer from response
-	if err := qc.cliCtx.Codec.UnmarshalJSON(response.Result, &account); err != nil && len(response.Result) != 0 {
-		panic(err)
+	for queueMsg := range queueMsgs {
+		qc.process(route, queueMsg)
 	}
+}
 
-	// get account number and sequence
-	accNum := account.GetAccountNumber()
-	accSeq := account.GetSequence()
+// handleHeimdallBroadcastMsg broadcasts heimdall msg from queue
+func (qc *QueueConnector) handleHeimdallBroadcastMsg(data []byte) error {
+	var msg sdk.Msg
+	if err := qc.cliCtx.Codec.UnmarshalJSON(data, &msg); err != nil {
+		qc.logger.Error("Error while parsing the heimdall transaction from queue", "error", err)
+		return permanentError{err}
+	}
 
-	// handler
-	handler := func(amqpMsg amqp.Delivery) bool {
-		var msg sdk.Msg
-		if err := qc.cliCtx.Codec.UnmarshalJSON(amqpMsg.Body, &msg); err != nil {
-			amqpMsg.Reject(false)
-			qc.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
-			return false
-		}
+	if _, err := qc.sequenceManager.BroadcastMsgs([]sdk.Msg{msg}); err != nil {
+		qc.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
+		return err
+	}
 
-		txBldr := authTypes.NewTxBuilderFromCLI().
-			WithTxEncoder(txEncoder).
-			WithAccountNumber(accNum).
-			WithSequence(accSeq).
-			WithChainID(chainID)
-		if _, err := helper.BuildAndBroadcastMsgs(qc.cliCtx, txBldr, []sdk.Msg{msg}); err != nil {
-			amqpMsg.Reject(false)
-			qc.logger.Error("Error while broadcasting the heimdall transaction", "error", err)
-			return false
-		}
+	return nil
+}
 
-		// send ack
-		amqpMsg.Ack(false)
+// handleBorBroadcastMsg sends bor tx from queue
+func (qc *QueueConnector) handleBorBroadcastMsg(data []byte) error {
+	var msg ethereum.CallMsg
+	if err := json.Unmarshal(data, &msg); err != nil {
+		qc.logger.Error("Error while parsing the transaction from queue", "error", err)
+		return permanentError{err}
+	}
 
-		// increment account sequence
-		accSeq = accSeq + 1
+	if msg.To == nil {
+		return permanentError{errors.New("Missing recipient in bor transaction")}
+	}
 
-		return true
+	// sign and broadcast transaction, tx manager tracks it until it is mined
+	tx, err := qc.borTxManager.SendTransaction(*msg.To, msg.Value, msg.Data)
+	if err != nil {
+		qc.logger.Error("Error while broadcasting the transaction", "error", err)
+		return err
 	}
 
-	// handle all amqp messages
-	for amqpMsg := range amqpMsgs {
-		handler(amqpMsg)
+	qc.logger.Debug("Sent transaction to bor", "TxHash", tx.Hash())
+	return nil
+}
+
+// process handles queue message. Failed message is acked after it is moved to retry store
+// (or dead-letter store, if it can't be processed at all), so it doesn't block the queue.
+func (qc *QueueConnector) process(route string, queueMsg QueueMsg) {
+	queueMessages.WithLabelValues(route, queueActionConsume).Inc()
+
+	if err := qc.routeHandlers[route](queueMsg.Body()); err != nil {
+		if storeErr := qc.handleFailure(route, queueMsg.Body(), 1, err); storeErr != nil {
+			qc.logger.Error("Error while storing failed queue message", "route", route, "body", string(queueMsg.Body()), "reason", err, "error", storeErr)
+			queueMsg.Reject()
+			queueMessages.WithLabelValues(route, queueActionReject).Inc()
+			return
+		}
+
+		queueMsg.Ack()
+		return
 	}
+
+	// send ack
+	queueMsg.Ack()
+	queueMessages.WithLabelValues(route, queueActionAck).Inc()
 }
 
-func (qc *QueueConnector) handleBorBroadcastMsgs(amqpMsgs <-chan amqp.Delivery) {
-	maticClient := helper.GetMaticClient()
+// retryFailedMsgs periodically retries failed messages which are due, until quit is closed
+func (qc *QueueConnector) retryFailedMsgs(quit <-chan struct{}) {
+	defer qc.handlers.Done()
 
-	// handler
-	handler := func(amqpMsg amqp.Delivery) bool {
-		var msg ethereum.CallMsg
-		if err := json.Unmarshal(amqpMsg.Body, &msg); err != nil {
-			amqpMsg.Reject(false)
-			qc.logger.Error("Error while parsing the transaction from queue", "error", err)
-			return false
-		}
+	ticker := time.NewTicker(qc.retryPolicy.InitialBackoff)
+	defer ticker.Stop()
 
-		// get auth
-		auth, err := helper.GenerateAuthObj(maticClient, *msg.To, msg.Data)
-		if err != nil {
-			amqpMsg.Reject(false)
-			qc.logger.Error("Error while fetching the transaction param details", "error", err)
-			return false
+	for {
+		select {
+		case <-ticker.C:
+			qc.retryDueMsgs(time.Now().UTC())
+		case <-quit:
+			return
 		}
+	}
+}
 
-		// Create the transaction, sign it and schedule it for execution
-		rawTx := types.NewTransaction(auth.Nonce.Uint64(), *msg.To, msg.Value, auth.GasLimit, auth.GasPrice, msg.Data)
-		// signer
-		signedTx, err := auth.Signer(types.HomesteadSigner{}, auth.From, rawTx)
-		if err != nil {
-			amqpMsg.Reject(false)
-			qc.logger.Error("Error while signing the transaction", "error", err)
-			return false
+// retryDueMsgs retries failed messages which are due by given time
+func (qc *QueueConnector) retryDueMsgs(now time.Time) {
+	retries, err := qc.retries.Due(now)
+	if err != nil {
+		qc.logger.Error("Error while fetching queue messages to retry", "error", err)
+		return
+	}
+
+	for _, retry := range retries {
+		handler, ok := qc.routeHandlers[retry.Route]
+		if !ok {
+			qc.logger.Error("No handler for route of failed queue message", "id", retry.ID, "route", retry.Route)
+			continue
 		}
 
-		qc.logger.Debug("Sending transaction to bor", "TxHash", signedTx.Hash())
+		qc.logger.Info("Retrying queue message", "id", retry.ID, "route", retry.Route, "attempt", retry.Attempts+1)
+		if err := handler(retry.Body); err != nil {
+			if storeErr := qc.handleFailure(retry.Route, retry.Body, retry.Attempts+1, err); storeErr != nil {
+				// keep message in retry store, it is retried again later
+				qc.logger.Error("Error while storing failed queue message", "id", retry.ID, "route", retry.Route, "reason", err, "error", storeErr)
+				continue
+			}
+		} else {
+			queueMessages.WithLabelValues(retry.Route, queueActionAck).Inc()
+		}
 
-		// broadcast transaction
-		if err := maticClient.SendTransaction(context.Background(), signedTx); err != nil {
-			amqpMsg.Reject(false)
-			qc.logger.Error("Error while broadcasting the transaction", "error", err)
-			return false
+		if err := qc.retries.Remove(retry.ID); err != nil {
+			qc.logger.Error("Error while removing queue message from retry store", "id", retry.ID, "error", err)
 		}
+	}
+}
 
-		// send ack
-		amqpMsg.Ack(false)
+// handleFailure moves message which failed given number of attempts to retry store, with exponential backoff.
+// Message which still fails after all attempts (or can't be processed at all) is moved to dead-letter store,
+// so it can be inspected and replayed later.
+func (qc *QueueConnector) handleFailure(route string, body []byte, attempts int, reason error) error {
+	if _, ok := reason.(permanentError); ok || attempts >= qc.retryPolicy.MaxAttempts {
+		deadLetter, err := qc.deadLetters.Add(route, body, attempts, reason)
+		if err != nil {
+			return err
+		}
 
-		// amqp msg
-		return true
+		qc.logger.Error("Moved queue message to dead-letter store", "id", deadLetter.ID, "route", route, "attempts", attempts, "error", reason)
+		queueMessages.WithLabelValues(route, queueActionDeadLetter).Inc()
+		return nil
 	}
 
-	// handle all amqp messages
-	for amqpMsg := range amqpMsgs {
-		handler(amqpMsg)
+	backoff := qc.retryPolicy.Backoff(attempts)
+	retry, err := qc.retries.Schedule(route, body, attempts, reason, time.Now().UTC().Add(backoff))
+	if err != nil {
+		return err
 	}
+
+	qc.logger.Info("Scheduled retry of queue message", "id", retry.ID, "route", route, "attempt", attempts, "backoff", backoff, "error", reason)
+	queueMessages.WithLabelValues(route, queueActionRetry).Inc()
+	return nil
 }
@@ -0,0 +1,267 @@
+package pier
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/streadway/amqp"
+)
+
+const (
+	// exchanges
+	broadcastExchange = "bridge.exchange.broadcast"
+	// heimdall queue
+	heimdallBroadcastQueue = "bridge.queue.heimdall"
+	// bor queue
+	borBroadcastQueue = "bridge.queue.bor"
+)
+
+// queue name for each routing key
+var amqpRouteQueues = map[string]string{
+	heimdallBroadcastRoute: heimdallBroadcastQueue,
+	borBroadcastRoute:      borBroadcastQueue,
+}
+
+// AMQPQueue queue backend using AMQP broker (RabbitMQ). Publishing and consuming use
+// separate channels, so stopping consumers doesn't affect publishers sharing the queue.
+type AMQPQueue struct {
+	// URL for connecting to AMQP
+	dialer string
+	// broker connection, re-dialed once closed
+	connection *amqp.Connection
+	// channel for publishing
+	publishChannel *amqp.Channel
+	// channel for consuming, closed on stop
+	consumeChannel *amqp.Channel
+
+	mutex sync.Mutex
+}
+
+// NewAMQPQueue dials AMQP broker and creates queue backend
+func NewAMQPQueue(dialer string) (*AMQPQueue, error) {
+	q := &AMQPQueue{dialer: dialer}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	if err := q.connect(); err != nil {
+		return nil, err
+	}
+
+	return q, nil
+}
+
+// Start declares exchange, binds all queues and opens channel for consuming.
+// Connection is re-dialed if it was closed (eg. by broker or previous stop).
+func (q *AMQPQueue) Start() error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if err := q.connect(); err != nil {
+		return err
+	}
+
+	channel, err := q.connection.Channel()
+	if err != nil {
+		return err
+	}
+
+	// exchange declare
+	if err := channel.ExchangeDeclare(
+		broadcastExchange, // name
+		"topic",           // type
+		true,              // durable
+		false,             // auto-deleted
+		false,             // internal
+		false,             // no-wait
+		nil,               // arguments
+	); err != nil {
+		channel.Close()
+		return err
+	}
+
+	for route, queue := range amqpRouteQueues {
+		// queue declare
+		if _, err := channel.QueueDeclare(
+			queue, // name
+			true,  // durable
+			false, // delete when usused
+			false, // exclusive
+			false, // no-wait
+			nil,   // arguments
+		); err != nil {
+			channel.Close()
+			return err
+		}
+
+		// bind queue
+		if err := channel.QueueBind(
+			queue,             // queue name
+			route,             // routing key
+			broadcastExchange, // exchange
+			false,
+			nil,
+		); err != nil {
+			channel.Close()
+			return err
+		}
+	}
+
+	if q.consumeChannel != nil {
+		q.consumeChannel.Close()
+	}
+	q.consumeChannel = channel
+	return nil
+}
+
+// Stop closes consume channel, which stops message delivery. Connection is kept for publishing.
+func (q *AMQPQueue) Stop() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.consumeChannel != nil {
+		q.consumeChannel.Close()
+		q.consumeChannel = nil
+	}
+}
+
+// Close stops message delivery and closes connection
+func (q *AMQPQueue) Close() {
+	q.Stop()
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.connection != nil {
+		q.connection.Close()
+		q.connection = nil
+		q.publishChannel = nil
+	}
+}
+
+// Publish publishes data on broadcast exchange with given routing key
+func (q *AMQPQueue) Publish(route string, data []byte) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if err := q.connect(); err != nil {
+		return err
+	}
+
+	err := q.publishChannel.Publish(
+		broadcastExchange, // exchange
+		route,             // routing key
+		false,             // mandatory
+		false,             // immediate
+		amqp.Publishing{
+			ContentType: "text/plain",
+			Body:        data,
+		})
+	if err != nil {
+		// channel is closed on error, open new one on next publish
+		q.publishChannel = nil
+	}
+	return err
+}
+
+// connect dials broker and opens publish channel unless they are open (expects lock to be held)
+func (q *AMQPQueue) connect() error {
+	if q.connection == nil || q.connection.IsClosed() {
+		conn, err := amqp.Dial(q.dialer)
+		if err != nil {
+			return err
+		}
+		q.connection = conn
+		q.publishChannel = nil
+		q.consumeChannel = nil
+	}
+
+	if q.publishChannel == nil {
+		channel, err := q.connection.Channel()
+		if err != nil {
+			return err
+		}
+		q.publishChannel = channel
+	}
+
+	return nil
+}
+
+// Consume starts consuming queue bound to given routing key
+func (q *AMQPQueue) Consume(route string) (<-chan QueueMsg, error) {
+	queue, ok := amqpRouteQueues[route]
+	if !ok {
+		return nil, fmt.Errorf("No queue found for route: %v", route)
+	}
+
+	q.mutex.Lock()
+	channel := q.consumeChannel
+	q.mutex.Unlock()
+	if channel == nil {
+		return nil, fmt.Errorf("Queue is not started")
+	}
+
+	// start consuming
+	deliveries, err := channel.Consume(
+		queue, // queue
+		queue, // consumer  -- consumer identifier
+		false, // auto-ack
+		false, // exclusive
+		false, // no-local
+		false, // no-wait
+		nil,   // args
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make(chan QueueMsg)
+	go func() {
+		defer close(msgs)
+		for delivery := range deliveries {
+			msgs <- amqpQueueMsg{delivery: delivery}
+		}
+	}()
+
+	return msgs, nil
+}
+
+// Depth returns number of messages ready in queue bound to given routing key
+func (q *AMQPQueue) Depth(route string) (int, error) {
+	queue, ok := amqpRouteQueues[route]
+	if !ok {
+		return 0, fmt.Errorf("No queue found for route: %v", route)
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if err := q.connect(); err != nil {
+		return 0, err
+	}
+
+	state, err := q.publishChannel.QueueInspect(queue)
+	if err != nil {
+		// channel is closed on error, open new one on next use
+		q.publishChannel = nil
+		return 0, err
+	}
+
+	return state.Messages, nil
+}
+
+// amqpQueueMsg wraps amqp delivery
+type amqpQueueMsg struct {
+	delivery amqp.Delivery
+}
+
+func (m amqpQueueMsg) Body() []byte {
+	return m.delivery.Body
+}
+
+func (m amqpQueueMsg) Ack() error {
+	return m.delivery.Ack(false)
+}
+
+func (m amqpQueueMsg) Reject() error {
+	return m.delivery.Reject(false)
+}
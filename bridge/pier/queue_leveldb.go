@@ -0,0 +1,265 @@
+package pier
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// storage key prefix for queued messages, followed by length prefixed route and sequence
+	levelDBQueuePrefix = "queue-"
+)
+
+// LevelDBQueue embedded durable queue backend stored in bridge db.
+// Messages are kept until they are acked or rejected, so pending messages
+// are delivered again after restart. Each route must have a single consumer.
+type LevelDBQueue struct {
+	// storage client
+	storageClient *leveldb.DB
+
+	// last sequence per route
+	sequences map[string]uint64
+	// notifies consumer of each route about new messages
+	notifiers map[string]chan struct{}
+	mutex     sync.Mutex
+
+	// closed on stop
+	quit chan struct{}
+
+	logger log.Logger
+}
+
+// NewLevelDBQueue creates queue backend on top of given leveldb instance
+func NewLevelDBQueue(storageClient *leveldb.DB) *LevelDBQueue {
+	return &LevelDBQueue{
+		storageClient: storageClient,
+		sequences:     make(map[string]uint64),
+		notifiers:     make(map[string]chan struct{}),
+		logger:        Logger.With("module", "leveldb-queue"),
+	}
+}
+
+// Start starts message delivery
+func (q *LevelDBQueue) Start() error {
+	if q.storageClient == nil {
+		return fmt.Errorf("Bridge db is not available for queue")
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+	q.quit = make(chan struct{})
+	return nil
+}
+
+// Stop stops message delivery. Bridge db is shared, it is closed once all services have stopped.
+func (q *LevelDBQueue) Stop() {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.quit != nil {
+		close(q.quit)
+		q.quit = nil
+	}
+}
+
+// Close stops message delivery, bridge db is closed separately
+func (q *LevelDBQueue) Close() {
+	q.Stop()
+}
+
+// Publish stores data on given route
+func (q *LevelDBQueue) Publish(route string, data []byte) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	sequence, err := q.lastSequence(route)
+	if err != nil {
+		return err
+	}
+	sequence = sequence + 1
+
+	if err := q.storageClient.Put(levelDBQueueKey(route, sequence), data, nil); err != nil {
+		return err
+	}
+	q.sequences[route] = sequence
+
+	// notify consumer without blocking
+	select {
+	case q.notifier(route) <- struct{}{}:
+	default:
+	}
+
+	return nil
+}
+
+// Consume returns channel which delivers messages for route one at a time,
+// next message is delivered only after previous one is acked or rejected
+func (q *LevelDBQueue) Consume(route string) (<-chan QueueMsg, error) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.quit == nil {
+		return nil, fmt.Errorf("Queue is not started")
+	}
+
+	msgs := make(chan QueueMsg)
+	go q.deliver(route, msgs, q.notifier(route), q.quit)
+	return msgs, nil
+}
+
+// Depth returns number of messages stored for route (including message being processed)
+func (q *LevelDBQueue) Depth(route string) (int, error) {
+	iterator := q.storageClient.NewIterator(util.BytesPrefix(levelDBQueueRoutePrefix(route)), nil)
+	defer iterator.Release()
+
+	count := 0
+	for iterator.Next() {
+		count++
+	}
+
+	return count, iterator.Error()
+}
+
+// deliver sends pending messages of route to consumer
+func (q *LevelDBQueue) deliver(route string, msgs chan<- QueueMsg, notify <-chan struct{}, quit <-chan struct{}) {
+	defer close(msgs)
+
+	for {
+		// deliver all pending messages
+		for {
+			msg, err := q.first(route)
+			if err != nil {
+				q.logger.Error("Error while reading message from queue", "route", route, "error", err)
+				break
+			}
+
+			if msg == nil {
+				break
+			}
+
+			select {
+			case msgs <- msg:
+			case <-quit:
+				return
+			}
+
+			// wait for ack or reject
+			select {
+			case <-msg.done:
+			case <-quit:
+				return
+			}
+		}
+
+		// wait for new messages
+		select {
+		case <-notify:
+		case <-quit:
+			return
+		}
+	}
+}
+
+// first returns oldest message for route
+func (q *LevelDBQueue) first(route string) (*levelDBQueueMsg, error) {
+	iterator := q.storageClient.NewIterator(util.BytesPrefix(levelDBQueueRoutePrefix(route)), nil)
+	defer iterator.Release()
+
+	if !iterator.First() {
+		return nil, iterator.Error()
+	}
+
+	// copy key and value as iterator reuses buffers
+	key := append([]byte{}, iterator.Key()...)
+	body := append([]byte{}, iterator.Value()...)
+
+	return &levelDBQueueMsg{
+		storageClient: q.storageClient,
+		key:           key,
+		body:          body,
+		done:          make(chan struct{}),
+	}, nil
+}
+
+// lastSequence returns last sequence used for route (expects lock to be held)
+func (q *LevelDBQueue) lastSequence(route string) (uint64, error) {
+	if sequence, ok := q.sequences[route]; ok {
+		return sequence, nil
+	}
+
+	iterator := q.storageClient.NewIterator(util.BytesPrefix(levelDBQueueRoutePrefix(route)), nil)
+	defer iterator.Release()
+
+	var sequence uint64
+	if iterator.Last() {
+		key := strings.TrimPrefix(string(iterator.Key()), string(levelDBQueueRoutePrefix(route)))
+		result, err := strconv.ParseUint(key, 10, 64)
+		if err != nil {
+			return 0, err
+		}
+		sequence = result
+	} else if err := iterator.Error(); err != nil {
+		return 0, err
+	}
+
+	q.sequences[route] = sequence
+	return sequence, nil
+}
+
+// notifier returns notification channel for route (expects lock to be held)
+func (q *LevelDBQueue) notifier(route string) chan struct{} {
+	if _, ok := q.notifiers[route]; !ok {
+		q.notifiers[route] = make(chan struct{}, 1)
+	}
+
+	return q.notifiers[route]
+}
+
+// levelDBQueueRoutePrefix returns key prefix for route. Route is length prefixed,
+// so prefix of one route never matches keys of another (eg. `x` and `x-y`).
+func levelDBQueueRoutePrefix(route string) []byte {
+	return []byte(fmt.Sprintf("%s%d-%s-", levelDBQueuePrefix, len(route), route))
+}
+
+// levelDBQueueKey returns storage key for message, zero padded sequence keeps keys ordered
+func levelDBQueueKey(route string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", levelDBQueueRoutePrefix(route), sequence))
+}
+
+// levelDBQueueMsg single message stored in leveldb queue
+type levelDBQueueMsg struct {
+	storageClient *leveldb.DB
+	key           []byte
+	body          []byte
+
+	// closed once message is acked or rejected
+	done chan struct{}
+	once sync.Once
+}
+
+func (m *levelDBQueueMsg) Body() []byte {
+	return m.body
+}
+
+func (m *levelDBQueueMsg) Ack() error {
+	return m.remove()
+}
+
+func (m *levelDBQueueMsg) Reject() error {
+	return m.remove()
+}
+
+// remove deletes message from storage and releases consumer for next message
+func (m *levelDBQueueMsg) remove() (err error) {
+	m.once.Do(func() {
+		err = m.storageClient.Delete(m.key, nil)
+		close(m.done)
+	})
+	return err
+}
@@ -0,0 +1,216 @@
+package pier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/storage"
+	"github.com/syndtr/goleveldb/leveldb/util"
+)
+
+const testRoute = "bridge.route.test"
+
+func newTestQueueDB(t *testing.T) *leveldb.DB {
+	db, err := leveldb.Open(storage.NewMemStorage(), nil)
+	require.NoError(t, err)
+	return db
+}
+
+func startTestQueue(t *testing.T, db *leveldb.DB) *LevelDBQueue {
+	q := NewLevelDBQueue(db)
+	require.NoError(t, q.Start())
+	return q
+}
+
+// receive waits for next message from queue
+func receive(t *testing.T, msgs <-chan QueueMsg) QueueMsg {
+	select {
+	case msg := <-msgs:
+		return msg
+	case <-time.After(2 * time.Second):
+		t.Fatal("Timed out waiting for queue message")
+	}
+	return nil
+}
+
+// requireNoMsg makes sure no message is delivered
+func requireNoMsg(t *testing.T, msgs <-chan QueueMsg) {
+	select {
+	case msg := <-msgs:
+		t.Fatalf("Unexpected queue message: %s", msg.Body())
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+// countRecords returns number of messages stored for route
+func countRecords(t *testing.T, db *leveldb.DB, route string) int {
+	iterator := db.NewIterator(util.BytesPrefix(levelDBQueueRoutePrefix(route)), nil)
+	defer iterator.Release()
+
+	count := 0
+	for iterator.Next() {
+		count++
+	}
+	require.NoError(t, iterator.Error())
+	return count
+}
+
+func TestLevelDBQueueFIFO(t *testing.T) {
+	db := newTestQueueDB(t)
+	defer db.Close()
+
+	q := startTestQueue(t, db)
+	defer q.Stop()
+
+	for _, data := range []string{"a", "b", "c"} {
+		require.NoError(t, q.Publish(testRoute, []byte(data)))
+	}
+
+	msgs, err := q.Consume(testRoute)
+	require.NoError(t, err)
+
+	for _, expected := range []string{"a", "b", "c"} {
+		msg := receive(t, msgs)
+		require.Equal(t, expected, string(msg.Body()))
+		require.NoError(t, msg.Ack())
+	}
+
+	// message published after consumer started
+	require.NoError(t, q.Publish(testRoute, []byte("d")))
+	msg := receive(t, msgs)
+	require.Equal(t, "d", string(msg.Body()))
+	require.NoError(t, msg.Ack())
+}
+
+func TestLevelDBQueueDeliversAfterAckOrReject(t *testing.T) {
+	db := newTestQueueDB(t)
+	defer db.Close()
+
+	q := startTestQueue(t, db)
+	defer q.Stop()
+
+	require.NoError(t, q.Publish(testRoute, []byte("a")))
+	require.NoError(t, q.Publish(testRoute, []byte("b")))
+	require.NoError(t, q.Publish(testRoute, []byte("c")))
+
+	msgs, err := q.Consume(testRoute)
+	require.NoError(t, err)
+
+	msg := receive(t, msgs)
+	require.Equal(t, "a", string(msg.Body()))
+	requireNoMsg(t, msgs)
+
+	// ack removes record and releases next message
+	require.NoError(t, msg.Ack())
+	require.Equal(t, 2, countRecords(t, db, testRoute))
+
+	msg = receive(t, msgs)
+	require.Equal(t, "b", string(msg.Body()))
+	requireNoMsg(t, msgs)
+
+	// reject removes record and releases next message
+	require.NoError(t, msg.Reject())
+	require.Equal(t, 1, countRecords(t, db, testRoute))
+
+	msg = receive(t, msgs)
+	require.Equal(t, "c", string(msg.Body()))
+	require.NoError(t, msg.Ack())
+	require.Equal(t, 0, countRecords(t, db, testRoute))
+}
+
+func TestLevelDBQueueRedeliversAfterRestart(t *testing.T) {
+	db := newTestQueueDB(t)
+	defer db.Close()
+
+	q := startTestQueue(t, db)
+	require.NoError(t, q.Publish(testRoute, []byte("a")))
+	require.NoError(t, q.Publish(testRoute, []byte("b")))
+
+	msgs, err := q.Consume(testRoute)
+	require.NoError(t, err)
+
+	// receive without ack and stop queue
+	msg := receive(t, msgs)
+	require.Equal(t, "a", string(msg.Body()))
+	q.Stop()
+
+	// restart same queue
+	require.NoError(t, q.Start())
+	msgs, err = q.Consume(testRoute)
+	require.NoError(t, err)
+
+	msg = receive(t, msgs)
+	require.Equal(t, "a", string(msg.Body()))
+	require.NoError(t, msg.Ack())
+	q.Stop()
+
+	// new queue on same db
+	q = startTestQueue(t, db)
+	defer q.Stop()
+
+	msgs, err = q.Consume(testRoute)
+	require.NoError(t, err)
+
+	msg = receive(t, msgs)
+	require.Equal(t, "b", string(msg.Body()))
+	require.NoError(t, msg.Ack())
+}
+
+func TestLevelDBQueueSequenceAfterReopen(t *testing.T) {
+	db := newTestQueueDB(t)
+	defer db.Close()
+
+	q := startTestQueue(t, db)
+	require.NoError(t, q.Publish(testRoute, []byte("a")))
+	require.NoError(t, q.Publish(testRoute, []byte("b")))
+	q.Stop()
+
+	// new queue resumes sequence from stored messages
+	q = startTestQueue(t, db)
+	defer q.Stop()
+
+	require.NoError(t, q.Publish(testRoute, []byte("c")))
+	require.Equal(t, uint64(3), q.sequences[testRoute])
+
+	has, err := db.Has(levelDBQueueKey(testRoute, 3), nil)
+	require.NoError(t, err)
+	require.True(t, has)
+
+	msgs, err := q.Consume(testRoute)
+	require.NoError(t, err)
+	for _, expected := range []string{"a", "b", "c"} {
+		msg := receive(t, msgs)
+		require.Equal(t, expected, string(msg.Body()))
+		require.NoError(t, msg.Ack())
+	}
+}
+
+func TestLevelDBQueueRoutesWithCommonPrefix(t *testing.T) {
+	db := newTestQueueDB(t)
+	defer db.Close()
+
+	q := startTestQueue(t, db)
+	require.NoError(t, q.Publish("x-y", []byte("xy")))
+	require.NoError(t, q.Publish("x", []byte("x")))
+	q.Stop()
+
+	// reopen, sequence for each route must be read from its own keys only
+	q = startTestQueue(t, db)
+	defer q.Stop()
+
+	require.NoError(t, q.Publish("x", []byte("x2")))
+	require.NoError(t, q.Publish("x-y", []byte("xy2")))
+	require.Equal(t, 2, countRecords(t, db, "x"))
+	require.Equal(t, 2, countRecords(t, db, "x-y"))
+
+	msgs, err := q.Consume("x")
+	require.NoError(t, err)
+	for _, expected := range []string{"x", "x2"} {
+		msg := receive(t, msgs)
+		require.Equal(t, expected, string(msg.Body()))
+		require.NoError(t, msg.Ack())
+	}
+	requireNoMsg(t, msgs)
+}
@@ -0,0 +1,71 @@
+package pier
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/maticnetwork/bor/accounts/abi"
+	"github.com/maticnetwork/bor/core/types"
+
+	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+// Resync scans logs of given mainchain block range and processes selected events (all events if none is selected).
+// Live `last-block` cursor is not moved, and events already recorded on heimdall are skipped.
+// Resync stops at first event which can't be checked or sent, and can be run again for the rest of range.
+func (syncer *Syncer) Resync(fromBlock uint64, toBlock uint64, events []string) error {
+	if fromBlock > toBlock {
+		return fmt.Errorf("Invalid block range: %v - %v", fromBlock, toBlock)
+	}
+
+	selected := make(map[string]bool)
+	for _, event := range events {
+		selected[event] = true
+	}
+
+	// filter for selected events which are not recorded yet
+	filter := func(eventName string, abiObject *abi.ABI, vLog *types.Log) (bool, error) {
+		if len(selected) > 0 && !selected[eventName] {
+			return false, nil
+		}
+
+		recorded, err := syncer.isEventRecorded(eventName, abiObject, vLog)
+		if err != nil {
+			syncer.Logger.Error("Error while checking if event is recorded", "event", eventName, "txHash", vLog.TxHash.Hex(), "error", err)
+			return false, err
+		}
+
+		if recorded {
+			syncer.Logger.Info("Event already recorded on heimdall, skipping", "event", eventName, "txHash", vLog.TxHash.Hex(), "logIndex", vLog.Index)
+			return false, nil
+		}
+
+		return true, nil
+	}
+
+	// last block is not moved
+	return syncer.processLogs(fromBlock, toBlock, filter, nil)
+}
+
+// isEventRecorded checks if heimdall already has given event (clerk record or staking/topup sequence).
+// Only 404 response means event is missing, any other failure is returned so that event is not sent twice.
+func (syncer *Syncer) isEventRecorded(eventName string, abiObject *abi.ABI, vLog *types.Log) (bool, error) {
+	ctx := context.Background()
+	switch eventName {
+	case stateSyncedEvent:
+		event := new(statesender.StatesenderStateSynced)
+		if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
+			logEventParseError(syncer.Logger, eventName, err)
+			return false, err
+		}
+		return syncer.heimdallClient.HasEventRecord(ctx, event.Id.Uint64())
+	case stakeInitEvent, unstakeInitEvent, stakeUpdateEvent, signerChange, reStakedEvent, jailedEvent, shareMintedEvent, shareBurnedEvent:
+		return syncer.heimdallClient.HasStakingSequence(ctx, vLog.TxHash.Hex(), uint64(vLog.Index))
+	case topupFeeEvent:
+		return syncer.heimdallClient.HasTopupSequence(ctx, vLog.TxHash.Hex(), uint64(vLog.Index))
+	default:
+		// heimdall rejects duplicates for other events
+		return false, nil
+	}
+}
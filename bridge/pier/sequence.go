@@ -0,0 +1,143 @@
+package pier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// error message returned by ante handler when tx is signed with wrong sequence
+const sequenceMismatchError = "verify correct account sequence"
+
+// SequenceManager keeps account number and sequence for heimdall broadcasts.
+// Broadcasts are serialized, and sequence is re-fetched from rest server
+// (and message is re-signed) whenever heimdall rejects tx because of sequence mismatch.
+// Txs are always broadcast in sync mode, so check tx result is known before sequence is incremented.
+type SequenceManager struct {
+	cliCtx         cliContext.CLIContext
+	heimdallClient *heimdallclient.Client
+	address        hmTypes.HeimdallAddress
+	chainID        string
+
+	// account number and next sequence
+	accNum uint64
+	accSeq uint64
+	loaded bool
+
+	// signs and broadcasts tx of given builder
+	broadcastTx func(txBldr authTypes.TxBuilder, msgs []sdk.Msg) (sdk.TxResponse, error)
+
+	mutex  sync.Mutex
+	logger log.Logger
+}
+
+// NewSequenceManager creates sequence manager for current validator account
+func NewSequenceManager(cliCtx cliContext.CLIContext) *SequenceManager {
+	sm := &SequenceManager{
+		cliCtx:         cliCtx,
+		heimdallClient: NewHeimdallClient(cliCtx.Codec),
+		address:        hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+		logger:         Logger.With("module", "sequence-manager"),
+	}
+	sm.broadcastTx = sm.signAndBroadcast
+	return sm
+}
+
+// BroadcastMsgs signs msgs with current sequence and broadcasts them to heimdall
+func (sm *SequenceManager) BroadcastMsgs(msgs []sdk.Msg) (sdk.TxResponse, error) {
+	sm.mutex.Lock()
+	defer sm.mutex.Unlock()
+
+	if !sm.loaded {
+		if err := sm.refresh(); err != nil {
+			return sdk.TxResponse{}, err
+		}
+	}
+
+	res, err := sm.broadcast(msgs)
+	if isSequenceMismatch(res, err) {
+		sm.logger.Info("Account sequence mismatch, re-fetching sequence", "sequence", sm.accSeq)
+		if err := sm.refresh(); err != nil {
+			return res, err
+		}
+
+		// re-sign with new sequence
+		res, err = sm.broadcast(msgs)
+	}
+
+	if err != nil {
+		return res, err
+	}
+
+	if res.Code != 0 {
+		// sequence might still be wrong, fetch it again on next broadcast
+		sm.loaded = false
+		return res, fmt.Errorf("Transaction failed with code %v: %v", res.Code, res.RawLog)
+	}
+
+	// increment account sequence
+	sm.accSeq = sm.accSeq + 1
+
+	return res, nil
+}
+
+// broadcast signs and broadcasts msgs with current sequence (expects lock to be held)
+func (sm *SequenceManager) broadcast(msgs []sdk.Msg) (sdk.TxResponse, error) {
+	txBldr := authTypes.NewTxBuilderFromCLI().
+		WithTxEncoder(helper.GetTxEncoder(sm.cliCtx.Codec)).
+		WithAccountNumber(sm.accNum).
+		WithSequence(sm.accSeq).
+		WithChainID(sm.chainID)
+
+	return sm.broadcastTx(txBldr, msgs)
+}
+
+// signAndBroadcast signs msgs with validator key and broadcasts tx in sync mode
+func (sm *SequenceManager) signAndBroadcast(txBldr authTypes.TxBuilder, msgs []sdk.Msg) (sdk.TxResponse, error) {
+	txBytes, err := helper.GetSignedTxBytes(sm.cliCtx, txBldr, msgs)
+	if err != nil {
+		return sdk.TxResponse{}, err
+	}
+
+	return helper.BroadcastTxBytes(sm.cliCtx, txBytes, helper.BroadcastSync)
+}
+
+// refresh fetches account number and sequence from rest server (expects lock to be held)
+func (sm *SequenceManager) refresh() error {
+	account, err := sm.heimdallClient.Account(context.Background(), sm.address)
+	if err != nil {
+		sm.logger.Error("Error fetching account sequence from rest-api", "address", sm.address, "error", err)
+		return err
+	}
+
+	// chain id
+	if sm.chainID == "" {
+		sm.chainID = helper.GetGenesisDoc().ChainID
+	}
+
+	sm.accNum = account.AccountNumber
+	sm.accSeq = account.Sequence
+	sm.loaded = true
+
+	sm.logger.Debug("Fetched account sequence", "accountNumber", sm.accNum, "sequence", sm.accSeq)
+	return nil
+}
+
+// isSequenceMismatch checks if tx was rejected because of wrong account sequence
+func isSequenceMismatch(res sdk.TxResponse, err error) bool {
+	if err != nil {
+		return strings.Contains(err.Error(), sequenceMismatchError)
+	}
+
+	return res.Code != 0 && strings.Contains(res.RawLog, sequenceMismatchError)
+}
@@ -0,0 +1,177 @@
+package pier
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	authTypes "github.com/maticnetwork/heimdall/auth/types"
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+const testMismatchLog = "signature verification failed; verify correct account sequence and chain-id"
+
+// testAccountServer serves account sequence of test address, and counts requests
+type testAccountServer struct {
+	*httptest.Server
+	sequence uint64
+	requests int32
+}
+
+func newTestAccountServer(t *testing.T, address hmTypes.HeimdallAddress, sequence uint64) *testAccountServer {
+	server := &testAccountServer{sequence: sequence}
+	server.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&server.requests, 1)
+		require.Equal(t, fmt.Sprintf(heimdallclient.AccountSequenceURL, address), r.URL.Path)
+		fmt.Fprintf(w, `{"height":"1","result":{"account_number":"1","sequence":"%v"}}`, atomic.LoadUint64(&server.sequence))
+	}))
+	return server
+}
+
+func newTestSequenceManager(server *testAccountServer, address hmTypes.HeimdallAddress, broadcastTx func(txBldr authTypes.TxBuilder, msgs []sdk.Msg) (sdk.TxResponse, error)) *SequenceManager {
+	config := heimdallclient.DefaultConfig(server.URL)
+	config.InitialBackoff = time.Millisecond
+	config.MaxBackoff = time.Millisecond
+
+	return &SequenceManager{
+		heimdallClient: heimdallclient.NewClient(codec.New(), config),
+		address:        address,
+		chainID:        "test-chain",
+		broadcastTx:    broadcastTx,
+		logger:         Logger,
+	}
+}
+
+func TestIsSequenceMismatch(t *testing.T) {
+	require.True(t, isSequenceMismatch(sdk.TxResponse{}, errors.New(testMismatchLog)))
+	require.True(t, isSequenceMismatch(sdk.TxResponse{Code: 4, RawLog: testMismatchLog}, nil))
+
+	require.False(t, isSequenceMismatch(sdk.TxResponse{}, nil))
+	require.False(t, isSequenceMismatch(sdk.TxResponse{}, errors.New("connection refused")))
+	require.False(t, isSequenceMismatch(sdk.TxResponse{Code: 12, RawLog: "out of gas"}, nil))
+}
+
+func TestSequenceManagerRefresh(t *testing.T) {
+	address := hmTypes.HexToHeimdallAddress("0x01")
+	server := newTestAccountServer(t, address, 5)
+	defer server.Close()
+
+	var sequences []uint64
+	sm := newTestSequenceManager(server, address, func(txBldr authTypes.TxBuilder, msgs []sdk.Msg) (sdk.TxResponse, error) {
+		require.EqualValues(t, 1, txBldr.AccountNumber())
+		require.Equal(t, "test-chain", txBldr.ChainID())
+		sequences = append(sequences, txBldr.Sequence())
+		return sdk.TxResponse{}, nil
+	})
+
+	// sequence is fetched once and incremented locally
+	for i := 0; i < 3; i++ {
+		_, err := sm.BroadcastMsgs(nil)
+		require.NoError(t, err)
+	}
+	require.Equal(t, []uint64{5, 6, 7}, sequences)
+	require.EqualValues(t, 1, atomic.LoadInt32(&server.requests))
+}
+
+func TestSequenceManagerMismatch(t *testing.T) {
+	address := hmTypes.HexToHeimdallAddress("0x01")
+	server := newTestAccountServer(t, address, 5)
+	defer server.Close()
+
+	var sequences []uint64
+	mismatch := false
+	sm := newTestSequenceManager(server, address, func(txBldr authTypes.TxBuilder, msgs []sdk.Msg) (sdk.TxResponse, error) {
+		sequences = append(sequences, txBldr.Sequence())
+		if mismatch {
+			return sdk.TxResponse{Code: 4, RawLog: testMismatchLog}, nil
+		}
+		return sdk.TxResponse{}, nil
+	})
+
+	_, err := sm.BroadcastMsgs(nil)
+	require.NoError(t, err)
+
+	// other tx consumed sequence, msg is re-signed once with fetched sequence
+	atomic.StoreUint64(&server.sequence, 9)
+	mismatch = true
+	sequences = nil
+	_, err = sm.BroadcastMsgs(nil)
+	require.Error(t, err)
+	require.Equal(t, []uint64{6, 9}, sequences)
+	require.EqualValues(t, 2, atomic.LoadInt32(&server.requests))
+
+	// successful re-sign
+	mismatch = false
+	sequences = nil
+	_, err = sm.BroadcastMsgs(nil)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{9}, sequences)
+}
+
+func TestSequenceManagerReloadAfterFailure(t *testing.T) {
+	address := hmTypes.HexToHeimdallAddress("0x01")
+	server := newTestAccountServer(t, address, 5)
+	defer server.Close()
+
+	code := uint32(12)
+	sm := newTestSequenceManager(server, address, func(txBldr authTypes.TxBuilder, msgs []sdk.Msg) (sdk.TxResponse, error) {
+		return sdk.TxResponse{Code: code, RawLog: "out of gas"}, nil
+	})
+
+	// failed tx is not retried, but sequence is fetched again before next broadcast
+	_, err := sm.BroadcastMsgs(nil)
+	require.Error(t, err)
+	require.False(t, sm.loaded)
+	require.EqualValues(t, 5, sm.accSeq)
+	require.EqualValues(t, 1, atomic.LoadInt32(&server.requests))
+
+	code = 0
+	_, err = sm.BroadcastMsgs(nil)
+	require.NoError(t, err)
+	require.True(t, sm.loaded)
+	require.EqualValues(t, 6, sm.accSeq)
+	require.EqualValues(t, 2, atomic.LoadInt32(&server.requests))
+}
+
+func TestSequenceManagerSerializesBroadcasts(t *testing.T) {
+	address := hmTypes.HexToHeimdallAddress("0x01")
+	server := newTestAccountServer(t, address, 0)
+	defer server.Close()
+
+	var inFlight int32
+	seen := make(map[uint64]bool)
+	sm := newTestSequenceManager(server, address, func(txBldr authTypes.TxBuilder, msgs []sdk.Msg) (sdk.TxResponse, error) {
+		require.EqualValues(t, 1, atomic.AddInt32(&inFlight, 1), "broadcasts must not overlap")
+		defer atomic.AddInt32(&inFlight, -1)
+
+		time.Sleep(time.Millisecond)
+		seen[txBldr.Sequence()] = true
+		return sdk.TxResponse{}, nil
+	})
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := sm.BroadcastMsgs(nil)
+			require.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	// every tx got its own sequence
+	require.Len(t, seen, 10)
+	require.EqualValues(t, 10, sm.accSeq)
+	require.EqualValues(t, 1, atomic.LoadInt32(&server.requests))
+}
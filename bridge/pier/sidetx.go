@@ -0,0 +1,194 @@
+package pier
+
+import (
+	"context"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	cliContext "github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/tendermint/tendermint/libs/common"
+	httpClient "github.com/tendermint/tendermint/rpc/client"
+
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/clerk"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/helper"
+	sidechannelTypes "github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	"github.com/maticnetwork/heimdall/topup"
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// SideTxService validates side txs pending on heimdall against mainchain and votes on them
+type SideTxService struct {
+	// Base service
+	common.BaseService
+
+	// cancel polling
+	cancelSideTxService context.CancelFunc
+
+	// side tx handlers by msg route
+	sideTxHandlers map[string]hmTypes.SideTxHandler
+
+	// ids of side txs already voted on (cache of votes stored on heimdall)
+	votedSideTxs map[string]bool
+
+	// signer address of validator
+	voter hmTypes.HeimdallAddress
+
+	// cli context
+	cliCtx cliContext.CLIContext
+
+	// queue connector
+	queueConnector *QueueConnector
+
+	// http client to subscribe to
+	httpClient *httpClient.HTTP
+
+	// heimdall rest client
+	heimdallClient *heimdallclient.Client
+}
+
+// NewSideTxService returns new service object
+func NewSideTxService(cdc *codec.Codec, queueConnector *QueueConnector, httpClient *httpClient.HTTP) *SideTxService {
+	// create logger
+	logger := Logger.With("module", SideTxServiceStr)
+
+	contractCaller, err := helper.NewContractCaller()
+	if err != nil {
+		logger.Error("Error while getting root chain instance", "error", err)
+		panic(err)
+	}
+
+	cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
+	cliCtx.BroadcastMode = client.BroadcastSync
+	cliCtx.TrustNode = true
+
+	// creating side tx service
+	sideTxService := &SideTxService{
+		sideTxHandlers: map[string]hmTypes.SideTxHandler{
+			stakingTypes.RouterKey:    staking.NewSideTxHandler(&contractCaller),
+			topupTypes.RouterKey:      topup.NewSideTxHandler(&contractCaller),
+			clerkTypes.RouterKey:      clerk.NewSideTxHandler(&contractCaller),
+			checkpointTypes.RouterKey: checkpoint.NewSideTxHandler(&contractCaller),
+		},
+		votedSideTxs: make(map[string]bool),
+		voter:        hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
+
+		cliCtx:         cliCtx,
+		queueConnector: queueConnector,
+		httpClient:     httpClient,
+		heimdallClient: NewHeimdallClient(cdc),
+	}
+
+	sideTxService.BaseService = *common.NewBaseService(logger, SideTxServiceStr, sideTxService)
+	return sideTxService
+}
+
+// OnStart starts polling for pending side txs
+func (s *SideTxService) OnStart() error {
+	s.BaseService.OnStart() // Always call the overridden method.
+
+	// create cancellable context
+	sideTxCtx, cancelSideTxService := context.WithCancel(context.Background())
+	s.cancelSideTxService = cancelSideTxService
+
+	// start polling for pending side txs
+	go s.startPolling(sideTxCtx, helper.GetConfig().SideTxPollingInterval)
+
+	s.Logger.Debug("Started side tx service")
+	return nil
+}
+
+// OnStop stops all necessary go routines
+func (s *SideTxService) OnStop() {
+	s.Logger.Info("Terminating side tx service")
+	s.BaseService.OnStop()
+	// cancel polling
+	s.cancelSideTxService()
+}
+
+// OnReset allows supervisor to restart stopped service
+func (s *SideTxService) OnReset() error {
+	return nil
+}
+
+// polls heimdall for pending side txs
+func (s *SideTxService) startPolling(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	// stop ticker when everything done
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.voteOnPendingSideTxs(ctx)
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// voteOnPendingSideTxs validates each pending side tx not voted on yet and broadcasts vote.
+// Side txs which can't be validated yet (eg. mainchain tx not confirmed) are retried on next poll.
+// Votes are checked on heimdall before voting, so side txs voted on before restart aren't voted again.
+func (s *SideTxService) voteOnPendingSideTxs(ctx context.Context) {
+	txs, err := s.heimdallClient.PendingSideTxs(ctx)
+	if err != nil {
+		s.Logger.Error("Error while fetching pending side txs", "error", err)
+		return
+	}
+
+	pending := make(map[string]bool, len(txs))
+	for _, tx := range txs {
+		id := tx.ID.String()
+		pending[id] = true
+		if s.votedSideTxs[id] {
+			continue
+		}
+
+		existing, err := s.heimdallClient.SideTxVote(ctx, tx.ID, s.voter)
+		if err != nil {
+			s.Logger.Error("Error while fetching side tx vote, will retry", "sideTxID", id, "error", err)
+			continue
+		}
+		if existing.Voted {
+			s.Logger.Debug("Already voted on side tx", "sideTxID", id, "vote", existing.Vote)
+			s.votedSideTxs[id] = true
+			continue
+		}
+
+		handler, ok := s.sideTxHandlers[tx.Msg.Route()]
+		if !ok {
+			s.Logger.Error("No side tx handler found", "sideTxID", id, "route", tx.Msg.Route())
+			continue
+		}
+
+		vote, err := handler(tx.Msg)
+		if err != nil {
+			s.Logger.Info("Unable to validate side tx, will retry", "sideTxID", id, "msgType", tx.Msg.Type(), "error", err)
+			continue
+		}
+
+		s.Logger.Info("Voting on side tx", "sideTxID", id, "msgType", tx.Msg.Type(), "vote", vote)
+		msg := sidechannelTypes.NewMsgSideTxVote(s.voter, tx.ID, vote)
+		if err := s.queueConnector.BroadcastToHeimdall(msg); err != nil {
+			s.Logger.Error("Error while sending side tx vote", "sideTxID", id, "error", err)
+			continue
+		}
+
+		s.votedSideTxs[id] = true
+	}
+
+	// forget side txs which are not pending anymore
+	for id := range s.votedSideTxs {
+		if !pending[id] {
+			delete(s.votedSideTxs, id)
+		}
+	}
+}
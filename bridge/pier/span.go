@@ -3,8 +3,6 @@ package pier
 import (
 	"bytes"
 	"context"
-	"encoding/json"
-	"net/http"
 	"strconv"
 	"time"
 
@@ -17,6 +15,7 @@ import (
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 
 	borTypes "github.com/maticnetwork/heimdall/bor/types"
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
 	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/types"
 )
@@ -47,6 +46,9 @@ type SpanService struct {
 
 	// http client to subscribe to
 	httpClient *httpClient.HTTP
+
+	// heimdall rest client
+	heimdallClient *heimdallclient.Client
 }
 
 // NewSpanService returns new service object
@@ -72,6 +74,7 @@ func NewSpanService(cdc *codec.Codec, queueConnector *QueueConnector, httpClient
 		cliCtx:         cliCtx,
 		queueConnector: queueConnector,
 		httpClient:     httpClient,
+		heimdallClient: NewHeimdallClient(cdc),
 	}
 
 	spanService.BaseService = *common.NewBaseService(logger, SpanServiceStr, spanService)
@@ -99,11 +102,13 @@ func (s *SpanService) OnStart() error {
 func (s *SpanService) OnStop() {
 	s.Logger.Info("Terminating span service")
 	s.BaseService.OnStop()
-	s.httpClient.Stop()
 	// cancel ack process
 	s.cancelSpanService()
-	// close bridge db instance
-	closeBridgeDBInstance()
+}
+
+// OnReset allows supervisor to restart stopped service
+func (s *SpanService) OnReset() error {
+	return nil
 }
 
 // polls heimdall and checks if new span needs to be proposed
@@ -189,18 +194,12 @@ func (s *SpanService) fetchLastSpan() (int, error) {
 // checks span status
 func (s *SpanService) getLastSpan() (*types.Span, error) {
 	// fetch latest start block from heimdall via rest query
-	result, err := FetchFromAPI(s.cliCtx, GetHeimdallServerEndpoint(LatestSpanURL))
+	lastSpan, err := s.heimdallClient.LatestSpan(context.Background())
 	if err != nil {
-		s.Logger.Error("Error while fetching latest span")
+		s.Logger.Error("Error while fetching latest span", "error", err)
 		return nil, err
 	}
-	var lastSpan types.Span
-	err = json.Unmarshal(result.Result, &lastSpan)
-	if err != nil {
-		s.Logger.Error("Error unmarshalling", "error", err)
-		return nil, err
-	}
-	return &lastSpan, nil
+	return lastSpan, nil
 }
 
 // getCurrentChildBlock gets the current child block
@@ -224,32 +223,19 @@ func (s *SpanService) isSpanProposer(nextSpanProducers []types.Validator) bool {
 }
 
 func (s *SpanService) fetchNextSpanDetails(id uint64, start uint64) (*types.Span, error) {
-	req, err := http.NewRequest("GET", GetHeimdallServerEndpoint(NextSpanInfoURL), nil)
-	if err != nil {
-		s.Logger.Error("Error creating a new request", "error", err)
-		return nil, err
-	}
-
-	q := req.URL.Query()
-	q.Add("span_id", strconv.FormatUint(id, 10))
-	q.Add("start_block", strconv.FormatUint(start, 10))
-	q.Add("chain_id", helper.GetConfig().BorChainID)
-	q.Add("proposer", helper.GetFromAddress(s.cliCtx).String())
-	req.URL.RawQuery = q.Encode()
-
 	// fetch next span details
-	result, err := FetchFromAPI(s.cliCtx, req.URL.String())
+	msg, err := s.heimdallClient.NextSpan(
+		context.Background(),
+		id,
+		start,
+		helper.GetConfig().BorChainID,
+		helper.GetFromAddress(s.cliCtx).String(),
+	)
 	if err != nil {
-		s.Logger.Error("Error fetching proposers", "error", err)
-		return nil, err
-	}
-
-	var msg types.Span
-	if err = json.Unmarshal(result.Result, &msg); err != nil {
-		s.Logger.Error("Error unmarshalling propose tx msg ", "error", err)
+		s.Logger.Error("Error fetching next span details", "error", err)
 		return nil, err
 	}
 
 	s.Logger.Debug("◽ Generated proposer span msg", "msg", msg.String())
-	return &msg, nil
+	return msg, nil
 }
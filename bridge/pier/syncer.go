@@ -2,11 +2,12 @@ package pier
 
 import (
 	"bytes"
-	"container/list"
 	"context"
-	"encoding/hex"
+	"fmt"
 	"math/big"
 	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -18,35 +19,52 @@ import (
 	"github.com/maticnetwork/bor/core/types"
 	"github.com/spf13/viper"
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
 	"github.com/tendermint/tendermint/libs/common"
 	httpClient "github.com/tendermint/tendermint/rpc/client"
 
-	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
-	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
-	"github.com/maticnetwork/heimdall/contracts/rootchain"
-	"github.com/maticnetwork/heimdall/contracts/stakinginfo"
-	"github.com/maticnetwork/heimdall/contracts/statesender"
+	"github.com/maticnetwork/heimdall/bridge/heimdallclient"
 	"github.com/maticnetwork/heimdall/helper"
-	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
-	topupTypes "github.com/maticnetwork/heimdall/topup/types"
-	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 const (
 	headerEvent      = "NewHeaderBlock"
 	stakeInitEvent   = "Staked"
 	unstakeInitEvent = "UnstakeInit"
+	stakeUpdateEvent = "StakeUpdate"
 	signerChange     = "SignerChange"
+	reStakedEvent    = "ReStaked"
+	jailedEvent      = "Jailed"
+	shareMintedEvent = "ShareMinted"
+	shareBurnedEvent = "ShareBurned"
+	stateSyncedEvent = "StateSynced"
+	topupFeeEvent    = "TopUpFee"
 
 	lastBlockKey = "last-block" // storage key
+
+	// storage key prefix for hashes of processed blocks, followed by zero padded block number
+	blockHashPrefix = "block-hash-"
+	// number of processed block hashes kept for reorg detection
+	maxTrackedBlockHashes = 128
+
+	// max number of blocks in single log query
+	maxLogsChunkSize = 1000
 )
 
-// LightHeader represent light header for in-memory queue
-type LightHeader struct {
-	Number *big.Int `json:"number"           gencodec:"required"`
-	Time   uint64   `json:"timestamp"        gencodec:"required"`
+// errors returned by rpc providers when log query covers too many blocks or results
+var rangeTooLargeErrors = []string{
+	"query returned more than",
+	"block range too large",
+	"block range is too wide",
+	"exceed maximum block range",
+	"range too large",
+	"response size exceeded",
+	"limit exceeded",
 }
 
+// logFilter selects events processed by syncer, error stops processing of the log
+type logFilter func(eventName string, abiObject *abi.ABI, vLog *types.Log) (bool, error)
+
 // Syncer syncs validators and checkpoints
 type Syncer struct {
 	// Base service
@@ -58,13 +76,15 @@ type Syncer struct {
 	// contract caller
 	contractConnector helper.ContractCaller
 
-	// ABIs
-	abis []*abi.ABI
+	// registered contract events
+	events *EventRegistry
 
 	// header channel
 	HeaderChannel chan *types.Header
 	// cancel function for poll/subscription
 	cancelSubscription context.CancelFunc
+	// consecutive new head subscription failures
+	subscriptionFailures int32 // accessed atomically
 
 	// header listener subscription
 	cancelHeaderProcess context.CancelFunc
@@ -78,11 +98,11 @@ type Syncer struct {
 	// http client to subscribe to
 	httpClient *httpClient.HTTP
 
-	// queue
-	headerQueue *list.List
+	// heimdall rest client
+	heimdallClient *heimdallclient.Client
 
-	// confirmation time
-	txConfirmationTime uint64
+	// number of blocks on top of a block before it is processed
+	confirmationBlocks uint64
 }
 
 // NewSyncer returns new service object for syncing events
@@ -96,10 +116,10 @@ func NewSyncer(cdc *codec.Codec, queueConnector *QueueConnector, httpClient *htt
 		panic(err)
 	}
 
-	abis := []*abi.ABI{
-		&contractCaller.RootChainABI,
-		&contractCaller.StateSenderABI,
-		&contractCaller.StakingInfoABI,
+	events := NewEventRegistry()
+	if err := registerDefaultEvents(events, contractCaller); err != nil {
+		logger.Error("Error while registering events", "error", err)
+		panic(err)
 	}
 
 	cliCtx := cliContext.NewCLIContext().WithCodec(cdc)
@@ -113,13 +133,13 @@ func NewSyncer(cdc *codec.Codec, queueConnector *QueueConnector, httpClient *htt
 		cliCtx:            cliCtx,
 		queueConnector:    queueConnector,
 		httpClient:        httpClient,
+		heimdallClient:    NewHeimdallClient(cdc),
 		contractConnector: contractCaller,
 
-		abis:          abis,
+		events:        events,
 		HeaderChannel: make(chan *types.Header),
 
-		headerQueue:        list.New(),
-		txConfirmationTime: uint64(helper.GetConfig().TxConfirmationTime.Seconds()),
+		confirmationBlocks: helper.GetConfirmationBlocks(),
 	}
 
 	syncer.BaseService = *common.NewBaseService(logger, ChainSyncer, syncer)
@@ -153,6 +173,13 @@ func (syncer *Syncer) OnStart() error {
 	// start header process
 	go syncer.startHeaderProcess(headerCtx)
 
+	// poll for new headers if subscription keeps failing
+	if failures := atomic.LoadInt32(&syncer.subscriptionFailures); failures >= maxSubscriptionFailures {
+		syncer.Logger.Info("New head subscription keeps failing, polling for new headers instead", "failures", failures)
+		go syncer.startPolling(ctx, helper.GetConfig().SyncerPollInterval)
+		return nil
+	}
+
 	// subscribe to new head
 	subscription, err := syncer.contractConnector.MainChainClient.SubscribeNewHead(ctx, syncer.HeaderChannel)
 	if err != nil {
@@ -173,9 +200,6 @@ func (syncer *Syncer) OnStart() error {
 func (syncer *Syncer) OnStop() {
 	syncer.BaseService.OnStop() // Always call the overridden method.
 
-	// close db
-	closeBridgeDBInstance()
-
 	// cancel subscription if any
 	syncer.cancelSubscription()
 
@@ -183,6 +207,11 @@ func (syncer *Syncer) OnStop() {
 	syncer.cancelHeaderProcess()
 }
 
+// OnReset allows supervisor to restart stopped service
+func (syncer *Syncer) OnReset() error {
+	return nil
+}
+
 // startPolling starts polling
 func (syncer *Syncer) startPolling(ctx context.Context, pollInterval time.Duration) {
 	// How often to fire the passed in function in second
@@ -209,11 +238,17 @@ func (syncer *Syncer) startPolling(ctx context.Context, pollInterval time.Durati
 }
 
 func (syncer *Syncer) startSubscription(ctx context.Context, subscription ethereum.Subscription) {
+	subscribedAt := time.Now()
 	for {
 		select {
 		case err := <-subscription.Err():
-			// stop service
-			syncer.Logger.Error("Error while subscribing new blocks", "error", err)
+			if time.Since(subscribedAt) >= subscriptionStablePeriod {
+				atomic.StoreInt32(&syncer.subscriptionFailures, 0)
+			}
+			failures := atomic.AddInt32(&syncer.subscriptionFailures, 1)
+
+			// stop service, supervisor restarts it
+			syncer.Logger.Error("Error while subscribing new blocks", "failures", failures, "error", err)
 			syncer.Stop()
 
 			// cancel subscription
@@ -228,40 +263,14 @@ func (syncer *Syncer) startSubscription(ctx context.Context, subscription ethere
 func (syncer *Syncer) processHeader(newHeader *types.Header) {
 	syncer.Logger.Debug("New block detected", "blockNumber", newHeader.Number)
 
-	// adding into queue
-	syncer.headerQueue.PushBack(&LightHeader{
-		Number: newHeader.Number,
-		Time:   newHeader.Time,
-	})
-
-	// current time
-	currentTime := uint64(time.Now().UTC().Unix())
-
-	var start *big.Int
-	var end *big.Int
-
-	// check start and end header
-	for syncer.headerQueue.Len() > 0 {
-		e := syncer.headerQueue.Front() // First element
-		h := e.Value.(*LightHeader)
-		if h.Time+syncer.txConfirmationTime > currentTime {
-			break
-		}
-
-		if start == nil {
-			start = h.Number
-		}
-		end = h.Number
-
-		syncer.headerQueue.Remove(e) // Dequeue
-	}
-
-	if start == nil {
+	// latest confirmed block
+	if newHeader.Number.Uint64() < syncer.confirmationBlocks {
 		return
 	}
+	toBlock := big.NewInt(0).SetUint64(newHeader.Number.Uint64() - syncer.confirmationBlocks)
 
 	// default fromBlock
-	fromBlock := start
+	fromBlock := toBlock
 	// get last block from storage
 	hasLastBlock, _ := syncer.storageClient.Has([]byte(lastBlockKey), nil)
 	if hasLastBlock {
@@ -273,23 +282,116 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 
 		syncer.Logger.Debug("Got last block from bridge storage", "lastBlock", string(lastBlockBytes))
 		if result, err := strconv.ParseUint(string(lastBlockBytes), 10, 64); err == nil {
-			if result > fromBlock.Uint64() {
-				fromBlock = big.NewInt(0).SetUint64(result)
+			// rewind to fork point if processed blocks are not canonical anymore
+			lastBlock, err := syncer.checkReorg(result)
+			if err != nil {
+				syncer.Logger.Error("Error while checking chain reorg", "error", err)
+				return
 			}
 
-			fromBlock = big.NewInt(0).SetUint64(result + 1)
+			fromBlock = big.NewInt(0).SetUint64(lastBlock + 1)
 		}
 	}
 
-	// to block
-	toBlock := end
+	if fromBlock.Cmp(toBlock) > 0 {
+		return
+	}
 
 	// debug log
 	syncer.Logger.Info("Processing header", "fromBlock", fromBlock, "toBlock", toBlock)
 
-	// set last block to storage
-	syncer.storageClient.Put([]byte(lastBlockKey), []byte(toBlock.String()), nil)
+	// process logs, last block is advanced only after each chunk is processed
+	if err := syncer.processLogs(fromBlock.Uint64(), toBlock.Uint64(), nil, syncer.setLastBlock); err != nil {
+		syncer.Logger.Error("Error while filtering logs from syncer", "error", err)
+	}
+}
+
+// setLastBlock stores block as last processed block along with its hash
+func (syncer *Syncer) setLastBlock(block *types.Header) error {
+	if err := syncer.storageClient.Put([]byte(lastBlockKey), []byte(block.Number.String()), nil); err != nil {
+		return err
+	}
+
+	syncer.storeBlockHash(block.Number.Uint64(), block.Hash())
+	lastProcessedBlock.Set(float64(block.Number.Uint64()))
+	return nil
+}
+
+// processLogs fetches and processes logs of given range in chunks of at most `maxLogsChunkSize` blocks.
+// Chunk is halved whenever provider reports that it is too large. afterChunk (if any) is called
+// with header of last block of each chunk once all logs of that chunk are processed. Processing
+// stops at first log which fails, so afterChunk is not called and the chunk is scanned again.
+func (syncer *Syncer) processLogs(
+	fromBlock uint64,
+	toBlock uint64,
+	filter logFilter,
+	afterChunk func(block *types.Header) error,
+) error {
+	chunkSize := uint64(maxLogsChunkSize)
+	for start := fromBlock; start <= toBlock; {
+		end := start + chunkSize - 1
+		if end > toBlock {
+			end = toBlock
+		}
+
+		// fetch header before logs, so stored hash belongs to processed chain
+		var header *types.Header
+		if afterChunk != nil {
+			var err error
+			header, err = syncer.contractConnector.MainChainClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(end))
+			if err != nil {
+				return err
+			}
+		}
+
+		// get all logs
+		logs, err := syncer.fetchLogs(new(big.Int).SetUint64(start), new(big.Int).SetUint64(end))
+		if err != nil {
+			if isRangeTooLargeError(err) && end > start {
+				chunkSize = (end - start + 1) / 2
+				syncer.Logger.Info("Log query range too large, halving chunk", "fromBlock", start, "toBlock", end, "chunkSize", chunkSize)
+				continue
+			}
+			return err
+		}
+
+		// logs of blocks reorged out while chunk was fetched are not processed, chunk is scanned again
+		if err := syncer.checkLogsCanonical(logs); err != nil {
+			return err
+		}
+
+		// process logs
+		for _, vLog := range logs {
+			if err := syncer.dispatchLog(vLog, filter); err != nil {
+				return err
+			}
+		}
+
+		if afterChunk != nil {
+			if err := afterChunk(header); err != nil {
+				return err
+			}
+		}
+
+		start = end + 1
+	}
+
+	return nil
+}
+
+// isRangeTooLargeError checks if provider rejected log query because of its size
+func isRangeTooLargeError(err error) bool {
+	message := strings.ToLower(err.Error())
+	for _, pattern := range rangeTooLargeErrors {
+		if strings.Contains(message, pattern) {
+			return true
+		}
+	}
+	return false
+}
 
+// fetchLogs returns logs of bridge contracts in given block range
+func (syncer *Syncer) fetchLogs(fromBlock *big.Int, toBlock *big.Int) ([]types.Log, error) {
 	// log
 	syncer.Logger.Info("Querying event logs", "fromBlock", fromBlock, "toBlock", toBlock)
 
@@ -297,314 +399,189 @@ func (syncer *Syncer) processHeader(newHeader *types.Header) {
 	query := ethereum.FilterQuery{
 		FromBlock: fromBlock,
 		ToBlock:   toBlock,
-		Addresses: []ethCommon.Address{
-			helper.GetRootChainAddress(),
-			helper.GetStakingInfoAddress(),
-			helper.GetStateSenderAddress(),
-		},
+		Addresses: syncer.events.Addresses(),
 	}
 
 	// get all logs
 	logs, err := syncer.contractConnector.MainChainClient.FilterLogs(context.Background(), query)
 	if err != nil {
-		syncer.Logger.Error("Error while filtering logs from syncer", "error", err)
-		return
+		return nil, err
 	} else if len(logs) > 0 {
 		syncer.Logger.Debug("New logs found", "numberOfLogs", len(logs))
 	}
 
-	// log
+	return logs, nil
+}
+
+// checkLogsCanonical checks that logs belong to blocks of canonical chain, ie. block hash of each log
+// matches hash of canonical block at its height
+func (syncer *Syncer) checkLogsCanonical(logs []types.Log) error {
+	canonical := make(map[uint64]ethCommon.Hash)
 	for _, vLog := range logs {
-		topic := vLog.Topics[0].Bytes()
-		for _, abiObject := range syncer.abis {
-			selectedEvent := helper.EventByID(abiObject, topic)
-			if selectedEvent != nil {
-				syncer.Logger.Debug("selectedEvent ", " event name -", selectedEvent.Name)
-				switch selectedEvent.Name {
-				case "NewHeaderBlock":
-					syncer.processCheckpointEvent(selectedEvent.Name, abiObject, &vLog)
-				// TODO remove post new bridge design
-				// case "Staked":
-				// 	syncer.processStakedEvent(selectedEvent.Name, abiObject, &vLog)
-				case "UnstakeInit":
-					syncer.processUnstakeInitEvent(selectedEvent.Name, abiObject, &vLog)
-				case "StakeUpdate":
-					syncer.processStakeUpdateEvent(selectedEvent.Name, abiObject, &vLog)
-				case "SignerChange":
-					syncer.processSignerChangeEvent(selectedEvent.Name, abiObject, &vLog)
-				case "ReStaked":
-					syncer.processReStakedEvent(selectedEvent.Name, abiObject, &vLog)
-				case "Jailed":
-					syncer.processJailedEvent(selectedEvent.Name, abiObject, &vLog)
-				case "StateSynced":
-					syncer.processStateSyncedEvent(selectedEvent.Name, abiObject, &vLog)
-				case "TopUpFee":
-					syncer.processTopupFeeEvent(selectedEvent.Name, abiObject, &vLog)
-					// case "Withdraw":
-					// 	syncer.processWithdrawEvent(selectedEvent.Name, abiObject, &vLog)
-				}
-				break
+		if vLog.Removed {
+			return fmt.Errorf("Log of reorged block %v (%v)", vLog.BlockNumber, vLog.BlockHash.Hex())
+		}
+
+		hash, ok := canonical[vLog.BlockNumber]
+		if !ok {
+			header, err := syncer.contractConnector.MainChainClient.HeaderByNumber(context.Background(), new(big.Int).SetUint64(vLog.BlockNumber))
+			if err != nil {
+				return err
 			}
+			hash = header.Hash()
+			canonical[vLog.BlockNumber] = hash
+		}
+
+		if hash != vLog.BlockHash {
+			syncer.Logger.Info("Log block is not canonical anymore", "block", vLog.BlockNumber, "logBlockHash", vLog.BlockHash.Hex(), "canonicalHash", hash.Hex())
+			return fmt.Errorf("Log of non canonical block %v (%v)", vLog.BlockNumber, vLog.BlockHash.Hex())
 		}
 	}
+
+	return nil
 }
 
-func (syncer *Syncer) processCheckpointEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
-	event := new(rootchain.RootchainNewHeaderBlock)
-	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-		logEventParseError(syncer.Logger, eventName, err)
-	} else {
-		syncer.Logger.Info(
-			"⬜ New event found",
-			"event", eventName,
-			"start", event.Start,
-			"end", event.End,
-			"reward", event.Reward,
-			"root", "0x"+hex.EncodeToString(event.Root[:]),
-			"proposer", event.Proposer.Hex(),
-			"headerNumber", event.HeaderBlockId,
-		)
-
-		// create msg checkpoint ack message
-		msg := checkpointTypes.NewMsgCheckpointAck(helper.GetFromAddress(syncer.cliCtx), event.HeaderBlockId.Uint64(), hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()), uint64(vLog.Index))
-		syncer.queueConnector.BroadcastToHeimdall(msg)
+// dispatchLog processes registered event of given log and broadcasts resulting msgs,
+// events for which filter returns false are skipped
+func (syncer *Syncer) dispatchLog(vLog types.Log, filter logFilter) error {
+	event, ok := syncer.events.lookup(&vLog)
+	if !ok {
+		return nil
 	}
-}
 
-func (syncer *Syncer) processStakedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
-	event := new(stakinginfo.StakinginfoStaked)
-	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-		logEventParseError(syncer.Logger, eventName, err)
-	} else {
-		syncer.Logger.Debug(
-			"⬜ New event found",
-			"event", eventName,
-			"validator", event.Signer,
-			"ID", event.ValidatorId,
-			"activatonEpoch", event.ActivationEpoch,
-			"amount", event.Amount,
-		)
-
-		// compare user to get address
-		if isEventSender(syncer.cliCtx, event.ValidatorId.Uint64()) {
-			pubkey := helper.GetPubKey()
-			msg := stakingTypes.NewMsgValidatorJoin(
-				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-				event.ValidatorId.Uint64(),
-				hmTypes.NewPubKey(pubkey[:]),
-				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				uint64(vLog.Index),
-			)
-
-			// process staked
-			syncer.queueConnector.BroadcastToHeimdall(msg)
+	syncer.Logger.Debug("selectedEvent ", " event name -", event.name)
+	if filter != nil {
+		selected, err := filter(event.name, event.abi, &vLog)
+		if err != nil || !selected {
+			return err
 		}
 	}
-}
 
-func (syncer *Syncer) processUnstakeInitEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
-	event := new(stakinginfo.StakinginfoUnstakeInit)
-	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-		logEventParseError(syncer.Logger, eventName, err)
-	} else {
-		syncer.Logger.Debug(
-			"⬜ New event found",
-			"event", eventName,
-			"validator", event.User,
-			"validatorID", event.ValidatorId,
-			"deactivatonEpoch", event.DeactivationEpoch,
-			"amount", event.Amount,
-		)
-
-		// msg validator exit
-		if isEventSender(syncer.cliCtx, event.ValidatorId.Uint64()) {
-			msg := stakingTypes.NewMsgValidatorExit(
-				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-				event.ValidatorId.Uint64(),
-				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				uint64(vLog.Index),
-			)
-
-			// broadcast heimdall
-			syncer.queueConnector.BroadcastToHeimdall(msg)
-		}
+	msgs, err := event.handler(EventContext{
+		CLIContext:     syncer.cliCtx,
+		HeimdallClient: syncer.heimdallClient,
+		Logger:         syncer.Logger,
+		ABI:            event.abi,
+		EventName:      event.name,
+	}, &vLog)
+	if err != nil {
+		logEventParseError(syncer.Logger, event.name, err)
+		return err
 	}
-}
 
-func (syncer *Syncer) processStakeUpdateEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
-	event := new(stakinginfo.StakinginfoStakeUpdate)
-	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-		logEventParseError(syncer.Logger, eventName, err)
-	} else {
-		syncer.Logger.Debug(
-			"⬜ New event found",
-			"event", eventName,
-			"validatorID", event.ValidatorId,
-			"newAmount", event.NewAmount,
-		)
-
-		// msg validator exit
-		if isEventSender(syncer.cliCtx, event.ValidatorId.Uint64()) {
-			msg := stakingTypes.NewMsgStakeUpdate(
-				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-				event.ValidatorId.Uint64(),
-				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				uint64(vLog.Index),
-			)
-
-			// broadcast heimdall
-			syncer.queueConnector.BroadcastToHeimdall(msg)
+	for _, msg := range msgs {
+		if err := syncer.queueConnector.BroadcastToHeimdall(msg); err != nil {
+			syncer.Logger.Error("Error while publishing msg to heimdall queue", "event", event.name, "txHash", vLog.TxHash.Hex(), "error", err)
+			return err
 		}
 	}
+
+	return nil
 }
 
-func (syncer *Syncer) processSignerChangeEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
-	event := new(stakinginfo.StakinginfoSignerChange)
-	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-		logEventParseError(syncer.Logger, eventName, err)
-	} else {
-		syncer.Logger.Debug(
-			"⬜ New event found",
-			"event", eventName,
-			"validatorID", event.ValidatorId,
-			"newSigner", event.NewSigner.Hex(),
-			"oldSigner", event.OldSigner.Hex(),
-		)
-
-		// signer change
-		if bytes.Compare(event.NewSigner.Bytes(), helper.GetAddress()) == 0 {
-			pubkey := helper.GetPubKey()
-			msg := stakingTypes.NewMsgSignerUpdate(
-				hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-				event.ValidatorId.Uint64(),
-				hmTypes.NewPubKey(pubkey[:]),
-				hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-				uint64(vLog.Index),
-			)
-
-			// process signer update
-			syncer.queueConnector.BroadcastToHeimdall(msg)
+// RegisterEvent registers handler for event of contract at given address.
+// It must be called before syncer is started.
+func (syncer *Syncer) RegisterEvent(address ethCommon.Address, abiObject *abi.ABI, eventName string, handler EventHandler) error {
+	return syncer.events.Register(address, abiObject, eventName, handler)
+}
+
+// checkReorg compares hashes of processed blocks with canonical chain.
+// If they don't match, last block is rewound to fork point, so logs are scanned again from there.
+func (syncer *Syncer) checkReorg(lastBlock uint64) (uint64, error) {
+	iterator := syncer.storageClient.NewIterator(util.BytesPrefix([]byte(blockHashPrefix)), nil)
+	defer iterator.Release()
+
+	// oldest tracked block which is not canonical
+	var oldestReorged *uint64
+
+	// newest tracked block first
+	for ok := iterator.Last(); ok; ok = iterator.Prev() {
+		number, err := strconv.ParseUint(strings.TrimPrefix(string(iterator.Key()), blockHashPrefix), 10, 64)
+		if err != nil {
+			return 0, err
 		}
+
+		// blocks after last block were never processed
+		if number > lastBlock {
+			continue
+		}
+
+		header, err := syncer.contractConnector.MainChainClient.HeaderByNumber(context.Background(), big.NewInt(0).SetUint64(number))
+		if err != nil {
+			return 0, err
+		}
+
+		if bytes.Equal(header.Hash().Bytes(), iterator.Value()) {
+			if number != lastBlock {
+				syncer.rewind(number)
+				syncer.Logger.Info("Chain reorg detected, rewinding last block to fork point", "lastBlock", lastBlock, "forkPoint", number)
+			}
+			return number, nil
+		}
+
+		oldestReorged = &number
 	}
-}
 
-func (syncer *Syncer) processReStakedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
-	event := new(stakinginfo.StakinginfoReStaked)
-	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-		logEventParseError(syncer.Logger, eventName, err)
-	} else {
-		syncer.Logger.Debug(
-			"⬜ New event found",
-			"event", eventName,
-			"validatorId", event.ValidatorId,
-			"amount", event.Amount,
-		)
-
-		// // msg validator exit
-		// msg := staking.NewMsgValidatorExit(
-		// 	hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-		// 	event.ValidatorId.Uint64(),
-		// 	vLog.TxHash,
-		// )
-
-		// // broadcast heimdall
-		// syncer.queueConnector.BroadcastToHeimdall(msg)
+	if err := iterator.Error(); err != nil {
+		return 0, err
 	}
-}
 
-func (syncer *Syncer) processJailedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
-	event := new(stakinginfo.StakinginfoJailed)
-	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-		logEventParseError(syncer.Logger, eventName, err)
-	} else {
-		syncer.Logger.Debug(
-			"⬜ New event found",
-			"event", eventName,
-			"validatorID", event.ValidatorId,
-			"exitEpoch", event.ExitEpoch,
-		)
-
-		// // msg validator exit
-		// msg := staking.NewMsgValidatorExit(
-		// 	hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-		// 	event.ValidatorId.Uint64(),
-		// 	vLog.TxHash,
-		// )
-
-		// // broadcast heimdall
-		// syncer.queueConnector.BroadcastToHeimdall(msg)
+	// reorg is deeper than tracked blocks, rescan from oldest tracked block
+	if oldestReorged != nil && *oldestReorged > 0 {
+		forkPoint := *oldestReorged - 1
+		syncer.rewind(forkPoint)
+		syncer.Logger.Error("Chain reorg deeper than tracked blocks, rewinding last block", "lastBlock", lastBlock, "forkPoint", forkPoint)
+		return forkPoint, nil
 	}
+
+	// nothing is tracked yet
+	return lastBlock, nil
 }
 
-//
-// Process withdraw event
-//
-
-// func (syncer *Syncer) processWithdrawEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
-// 	event := new(depositmanager.DepositmanagerDeposit)
-// 	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-// 		logEventParseError(syncer.Logger, eventName, err)
-// 	} else {
-// 		syncer.Logger.Debug(
-// 			"New event found",
-// 			"event", eventName,
-// 			"user", event.User,
-// 			"depositCount", event.DepositCount,
-// 			"token", event.Token.String(),
-// 		)
-
-// 		// TODO dispatch to heimdall
-// 	}
-// }
-
-//
-// Process state synced event
-//
-
-func (syncer *Syncer) processStateSyncedEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
-	event := new(statesender.StatesenderStateSynced)
-	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-		logEventParseError(syncer.Logger, eventName, err)
-	} else {
-		syncer.Logger.Debug(
-			"⬜ New event found",
-			"event", eventName,
-			"id", event.Id,
-			"contract", event.ContractAddress,
-			"data", hex.EncodeToString(event.Data),
-			"borChainId", helper.GetConfig().BorChainID,
-		)
-
-		// create clerk event record
-		msg := clerkTypes.NewMsgEventRecord(
-			hmTypes.BytesToHeimdallAddress(helper.GetAddress()),
-			hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()),
-			uint64(vLog.Index),
-			event.Id.Uint64(),
-			helper.GetConfig().BorChainID,
-		)
-
-		// broadcast to heimdall
-		syncer.queueConnector.BroadcastToHeimdall(msg)
+// rewind sets last block to fork point and removes hashes of blocks after it
+func (syncer *Syncer) rewind(forkPoint uint64) {
+	iterator := syncer.storageClient.NewIterator(util.BytesPrefix([]byte(blockHashPrefix)), nil)
+	defer iterator.Release()
+
+	batch := new(leveldb.Batch)
+	for iterator.Next() {
+		if string(iterator.Key()) > string(blockHashKey(forkPoint)) {
+			batch.Delete(append([]byte{}, iterator.Key()...))
+		}
+	}
+	batch.Put([]byte(lastBlockKey), []byte(strconv.FormatUint(forkPoint, 10)))
+
+	if err := syncer.storageClient.Write(batch, nil); err != nil {
+		syncer.Logger.Error("Error while rewinding last block", "forkPoint", forkPoint, "error", err)
 	}
 }
 
-// processTopupFeeEvent
-func (syncer *Syncer) processTopupFeeEvent(eventName string, abiObject *abi.ABI, vLog *types.Log) {
+// storeBlockHash stores hash of processed block and prunes old hashes
+func (syncer *Syncer) storeBlockHash(number uint64, hash ethCommon.Hash) {
+	if err := syncer.storageClient.Put(blockHashKey(number), hash.Bytes(), nil); err != nil {
+		syncer.Logger.Error("Error while storing block hash", "block", number, "error", err)
+		return
+	}
+
+	iterator := syncer.storageClient.NewIterator(util.BytesPrefix([]byte(blockHashPrefix)), nil)
+	defer iterator.Release()
 
-	event := new(stakinginfo.StakinginfoTopUpFee)
-	if err := helper.UnpackLog(abiObject, event, eventName, vLog); err != nil {
-		logEventParseError(syncer.Logger, eventName, err)
-	} else {
-		syncer.Logger.Info(
-			"New event found",
-			"event", eventName,
-			"validatorId", event.ValidatorId,
-			"Fee", event.Fee,
-		)
-
-		// create msg checkpoint ack message
-		msg := topupTypes.NewMsgTopup(helper.GetFromAddress(syncer.cliCtx), event.ValidatorId.Uint64(), hmTypes.BytesToHeimdallHash(vLog.TxHash.Bytes()), uint64(vLog.Index))
-		syncer.queueConnector.BroadcastToHeimdall(msg)
+	var keys [][]byte
+	for iterator.Next() {
+		keys = append(keys, append([]byte{}, iterator.Key()...))
+	}
+
+	// remove oldest hashes
+	batch := new(leveldb.Batch)
+	for i := 0; i < len(keys)-maxTrackedBlockHashes; i++ {
+		batch.Delete(keys[i])
 	}
+	if batch.Len() > 0 {
+		syncer.storageClient.Write(batch, nil)
+	}
+}
+
+// blockHashKey returns storage key for block hash, zero padded number keeps keys ordered
+func blockHashKey(number uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", blockHashPrefix, number))
 }
@@ -0,0 +1,134 @@
+package pier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/maticnetwork/bor/accounts/abi"
+	ethCommon "github.com/maticnetwork/bor/common"
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/maticnetwork/bor/rpc"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/common"
+
+	"github.com/maticnetwork/heimdall/helper"
+)
+
+func TestIsRangeTooLargeError(t *testing.T) {
+	require.True(t, isRangeTooLargeError(errors.New("query returned more than 10000 results")))
+	require.True(t, isRangeTooLargeError(errors.New("Block range too large")))
+	require.True(t, isRangeTooLargeError(errors.New("exceed maximum block range: 5000")))
+
+	require.False(t, isRangeTooLargeError(errors.New("connection refused")))
+	require.False(t, isRangeTooLargeError(errors.New("context deadline exceeded")))
+}
+
+func TestDispatchLogError(t *testing.T) {
+	abiObject, err := abi.JSON(strings.NewReader(testEventsABI))
+	require.NoError(t, err)
+
+	address := ethCommon.HexToAddress("0x01")
+	parseErr := errors.New("parse failed")
+	registry := NewEventRegistry()
+	require.NoError(t, registry.Register(address, &abiObject, "Ping", func(ctx EventContext, vLog *types.Log) ([]sdk.Msg, error) {
+		return nil, parseErr
+	}))
+
+	syncer := &Syncer{events: registry}
+	syncer.BaseService = *common.NewBaseService(Logger, ChainSyncer, syncer)
+
+	// failed event is reported, so chunk is not marked as processed
+	vLog := types.Log{Address: address, Topics: []ethCommon.Hash{abiObject.Events["Ping"].Id()}}
+	require.Equal(t, parseErr, syncer.dispatchLog(vLog, nil))
+
+	// skipped and unknown events are not errors
+	require.NoError(t, syncer.dispatchLog(vLog, func(eventName string, abiObject *abi.ABI, vLog *types.Log) (bool, error) { return false, nil }))
+	require.NoError(t, syncer.dispatchLog(types.Log{Address: address}, nil))
+}
+
+// testSubscription subscription which fails with error sent on its channel
+type testSubscription struct {
+	errCh chan error
+}
+
+func (s testSubscription) Unsubscribe()      {}
+func (s testSubscription) Err() <-chan error { return s.errCh }
+
+func TestSubscriptionFailures(t *testing.T) {
+	syncer := &Syncer{cancelSubscription: func() {}}
+	syncer.BaseService = *common.NewBaseService(Logger, ChainSyncer, syncer)
+
+	// failures are counted by subscription routine while service is restarted
+	for i := 1; i <= maxSubscriptionFailures; i++ {
+		subscription := testSubscription{errCh: make(chan error, 1)}
+		done := make(chan struct{})
+		go func() {
+			syncer.startSubscription(context.Background(), subscription)
+			close(done)
+		}()
+
+		require.EqualValues(t, i-1, atomic.LoadInt32(&syncer.subscriptionFailures))
+		subscription.errCh <- errors.New("subscription failed")
+		<-done
+		require.EqualValues(t, i, atomic.LoadInt32(&syncer.subscriptionFailures))
+	}
+}
+
+// testChainServer json-rpc server which returns canonical headers with given block numbers
+func testChainServer(t *testing.T) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage   `json:"id"`
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		require.Equal(t, "eth_getBlockByNumber", req.Method)
+
+		var number hexutil.Big
+		require.NoError(t, json.Unmarshal(req.Params[0], &number))
+		header, err := json.Marshal(testHeader(number.ToInt().Uint64()))
+		require.NoError(t, err)
+		fmt.Fprintf(w, `{"jsonrpc":"2.0","id":%s,"result":%s}`, req.ID, header)
+	}))
+}
+
+func testHeader(number uint64) *types.Header {
+	return &types.Header{Number: new(big.Int).SetUint64(number), Difficulty: big.NewInt(1)}
+}
+
+func TestCheckLogsCanonical(t *testing.T) {
+	server := testChainServer(t)
+	defer server.Close()
+
+	rpcClient, err := rpc.DialHTTP(server.URL)
+	require.NoError(t, err)
+
+	syncer := &Syncer{contractConnector: helper.ContractCaller{MainChainClient: ethclient.NewClient(rpcClient)}}
+	syncer.BaseService = *common.NewBaseService(Logger, ChainSyncer, syncer)
+
+	logs := []types.Log{
+		{BlockNumber: 5, BlockHash: testHeader(5).Hash()},
+		{BlockNumber: 5, BlockHash: testHeader(5).Hash(), Index: 1},
+		{BlockNumber: 7, BlockHash: testHeader(7).Hash()},
+	}
+	require.NoError(t, syncer.checkLogsCanonical(logs))
+
+	// log of block reorged out after logs were fetched
+	reorged := append(logs, types.Log{BlockNumber: 8, BlockHash: ethCommon.HexToHash("0x08")})
+	require.Error(t, syncer.checkLogsCanonical(reorged))
+
+	removed := append(logs, types.Log{BlockNumber: 7, BlockHash: testHeader(7).Hash(), Removed: true})
+	require.Error(t, syncer.checkLogsCanonical(removed))
+}
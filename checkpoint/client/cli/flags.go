@@ -9,4 +9,6 @@ const (
 	FlagHeaderNumber       = "header"
 	FlagCheckpointTxHash   = "txhash"
 	FlagCheckpointLogIndex = "log-index"
+	FlagBlockNumber        = "block-number"
+	FlagValidatorID        = "id"
 )
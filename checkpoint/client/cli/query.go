@@ -15,6 +15,7 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	hmClient "github.com/maticnetwork/heimdall/client"
+	hmTypes "github.com/maticnetwork/heimdall/types"
 	"github.com/maticnetwork/heimdall/version"
 )
 
@@ -37,6 +38,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 			GetLastNoACK(cdc),
 			GetHeaderFromIndex(cdc),
 			GetCheckpointCount(cdc),
+			GetCheckpointProof(cdc),
+			GetProposerStats(cdc),
 		)...,
 	)
 
@@ -159,6 +162,97 @@ func GetHeaderFromIndex(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetCheckpointProof get proof of bor block inclusion in checkpoint
+func GetCheckpointProof(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proof",
+		Short: "get proof of bor block inclusion in checkpoint",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			blockNumber := viper.GetInt64(FlagBlockNumber)
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockParams(uint64(blockNumber)))
+			if err != nil {
+				return err
+			}
+
+			// fetch checkpoint which contains block
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return fmt.Errorf("No checkpoint found for block %v", blockNumber)
+			}
+
+			var checkpoint types.BlockCheckpoint
+			if err := json.Unmarshal(res, &checkpoint); err != nil {
+				return err
+			}
+
+			// rebuild checkpoint tree from bor headers
+			proof, err := types.NewBlockProof(checkpoint, uint64(blockNumber))
+			if err != nil {
+				return err
+			}
+
+			result, err := json.Marshal(proof)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(result))
+			return nil
+		},
+	}
+	cmd.Flags().Int64(FlagBlockNumber, 0, "--block-number=<bor-block-number>")
+	cmd.MarkFlagRequired(FlagBlockNumber)
+
+	return cmd
+}
+
+// GetProposerStats get checkpoint proposer stats of validator, or of all validators
+func GetProposerStats(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "proposer-stats",
+		Short: "get checkpoint proposer stats of validator (all validators if id is not given)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if !cmd.Flags().Changed(FlagValidatorID) {
+				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllProposerStats), nil)
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(res))
+				return nil
+			}
+
+			// get query params
+			validatorID := hmTypes.NewValidatorID(viper.GetUint64(FlagValidatorID))
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(validatorID))
+			if err != nil {
+				return err
+			}
+
+			// fetch stats
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerStats), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator-id>")
+
+	return cmd
+}
+
 // GetCheckpointCount get number of checkpoint received count
 func GetCheckpointCount(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
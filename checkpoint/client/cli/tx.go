@@ -146,8 +146,28 @@ func SendCheckpointACKTx(cdc *codec.Codec) *cobra.Command {
 
 			checkpointTxHash := hmTypes.BytesToHeimdallHash(common.FromHex(checkpointTxHashStr))
 
+			contractCallerObj, err := helper.NewContractCaller()
+			if err != nil {
+				return err
+			}
+
+			// fetch header block details from rootchain
+			root, start, end, _, checkpointProposer, err := contractCallerObj.GetHeaderInfo(headerBlock)
+			if err != nil {
+				return err
+			}
+
 			// new checkpoint
-			msg := types.NewMsgCheckpointAck(proposer, headerBlock, checkpointTxHash, uint64(viper.GetInt64(FlagCheckpointLogIndex)))
+			msg := types.NewMsgCheckpointAck(
+				proposer,
+				headerBlock,
+				checkpointProposer,
+				start,
+				end,
+				hmTypes.BytesToHeimdallHash(root.Bytes()),
+				checkpointTxHash,
+				uint64(viper.GetInt64(FlagCheckpointLogIndex)),
+			)
 
 			// msg
 			return helper.BroadcastMsgsWithCLI(cliCtx, []sdk.Msg{msg})
@@ -15,7 +15,6 @@ import (
 	"github.com/maticnetwork/bor/common"
 	ethcmn "github.com/maticnetwork/bor/common"
 	"github.com/maticnetwork/heimdall/checkpoint/types"
-	"github.com/maticnetwork/heimdall/helper"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 	hmRest "github.com/maticnetwork/heimdall/types/rest"
@@ -42,6 +41,26 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		latestCheckpointHandlerFunc(cliCtx),
 	).Methods("GET")
 
+	r.HandleFunc("/checkpoint/proposer-stats",
+		allProposerStatsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/proposer-stats/{id}",
+		proposerStatsHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/block/latest",
+		lastCheckpointedBlockHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/block/{blockNumber}",
+		checkpointByBlockHandlerFn(cliCtx),
+	).Methods("GET")
+
+	r.HandleFunc("/checkpoint/proof/{blockNumber}",
+		checkpointProofHandlerFn(cliCtx),
+	).Methods("GET")
+
 	r.HandleFunc("/checkpoint/{checkpointNumber}",
 		checkpointByNumberHandlerFunc(cliCtx),
 	).Methods("GET")
@@ -169,6 +188,185 @@ func checkpointHeaderHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
+// allProposerStatsHandlerFn returns checkpoint proposer stats of all validators
+func allProposerStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// fetch stats
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryAllProposerStats), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// proposerStatsHandlerFn returns checkpoint proposer stats of validator
+func proposerStatsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get validator id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch stats
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposerStats), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// checkpointByBlockHandlerFn returns checkpoint which contains bor block
+func checkpointByBlockHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get block number
+		blockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["blockNumber"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockParams(blockNumber))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch checkpoint
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, result, "No checkpoint found for block"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// lastCheckpointedBlockHandlerFn returns last bor block covered by checkpoints
+func lastCheckpointedBlockHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// fetch last checkpointed block
+		result, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryLastCheckpointedBlock), nil)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// check content
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, result, "No checkpoint found"); !ok {
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
+// checkpointProofHandlerFn returns proof of bor block inclusion in checkpoint
+func checkpointProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		// get block number
+		blockNumber, ok := rest.ParseUint64OrReturnBadRequest(w, vars["blockNumber"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryBlockParams(blockNumber))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		// fetch checkpoint which contains block
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointByBlock), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// error if no checkpoint found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found for block"); !ok {
+			return
+		}
+
+		var checkpoint types.BlockCheckpoint
+		if err := json.Unmarshal(res, &checkpoint); err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// rebuild checkpoint tree from bor headers
+		proof, err := types.NewBlockProof(checkpoint, blockNumber)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		result, err := json.Marshal(proof)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, result)
+	}
+}
+
 // HeaderBlockResult represents header block result
 type HeaderBlockResult struct {
 	Proposer   hmTypes.HeimdallAddress `json:"proposer"`
@@ -417,10 +615,10 @@ func latestCheckpointHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		//
 
 		RestLogger.Debug("ACK Count fetched", "ackCount", ackCount)
-		lastCheckpointKey := helper.GetConfig().ChildBlockInterval * ackCount
+		lastCheckpointKey := types.ChildBlockInterval * ackCount
 		RestLogger.Debug("Last checkpoint key generated",
 			"lastCheckpointKey", lastCheckpointKey,
-			"min", helper.GetConfig().ChildBlockInterval,
+			"min", types.ChildBlockInterval,
 		)
 
 		// get query params
@@ -465,10 +663,10 @@ func checkpointByNumberHandlerFunc(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		RestLogger.Debug("Get Checkpoint for ", "checkpointNumber", checkpointNumber)
-		checkpointKey := helper.GetConfig().ChildBlockInterval * checkpointNumber
+		checkpointKey := types.ChildBlockInterval * checkpointNumber
 		RestLogger.Debug("checkpoint key generated",
 			"checkpointKey", checkpointKey,
-			"min", helper.GetConfig().ChildBlockInterval,
+			"min", types.ChildBlockInterval,
 		)
 
 		// get query params
@@ -40,10 +40,14 @@ type (
 	HeaderACKReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		Proposer    hmTypes.HeimdallAddress `json:"proposer"`
-		HeaderBlock uint64                  `json:"headerBlock"`
-		TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
-		LogIndex    uint64                  `json:"log_index"`
+		Proposer           hmTypes.HeimdallAddress `json:"proposer"`
+		HeaderBlock        uint64                  `json:"headerBlock"`
+		CheckpointProposer hmTypes.HeimdallAddress `json:"checkpoint_proposer"`
+		StartBlock         uint64                  `json:"start_block"`
+		EndBlock           uint64                  `json:"end_block"`
+		RootHash           hmTypes.HeimdallHash    `json:"root_hash"`
+		TxHash             hmTypes.HeimdallHash    `json:"tx_hash"`
+		LogIndex           uint64                  `json:"log_index"`
 	}
 
 	// HeaderNoACKReq struct for sending no-ack for a new headers
@@ -94,7 +98,16 @@ func newCheckpointACKHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		}
 
 		// draft a message and send response
-		msg := types.NewMsgCheckpointAck(req.Proposer, req.HeaderBlock, req.TxHash, req.LogIndex)
+		msg := types.NewMsgCheckpointAck(
+			req.Proposer,
+			req.HeaderBlock,
+			req.CheckpointProposer,
+			req.StartBlock,
+			req.EndBlock,
+			req.RootHash,
+			req.TxHash,
+			req.LogIndex,
+		)
 
 		// send response
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
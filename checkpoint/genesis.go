@@ -6,7 +6,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
-	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
@@ -30,7 +29,7 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 		// load checkpoints to state
 		for i, header := range data.Headers {
-			checkpointHeaderIndex := helper.GetConfig().ChildBlockInterval * (uint64(i) + 1)
+			checkpointHeaderIndex := types.ChildBlockInterval * (uint64(i) + 1)
 			keeper.AddCheckpoint(ctx, checkpointHeaderIndex, header)
 		}
 	}
@@ -42,6 +41,11 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 
 	// Set initial ack count
 	keeper.UpdateACKCountWithValue(ctx, data.AckCount)
+
+	// Set proposer stats
+	for _, stats := range data.ProposerStats {
+		keeper.SetProposerStats(ctx, stats)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -55,5 +59,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		keeper.GetLastNoAck(ctx),
 		keeper.GetACKCount(ctx),
 		hmTypes.SortHeaders(keeper.GetCheckpointHeaders(ctx)),
+		keeper.GetAllProposerStats(ctx),
 	)
 }
@@ -9,20 +9,20 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
-// NewHandler creates new handler for handling messages for checkpoint module
-func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
+// NewHandler creates new handler for handling messages for checkpoint module.
+// MsgCheckpoint and MsgCheckpointAck reach it through sidechannel, once validators voted for them.
+func NewHandler(k Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 		switch msg := msg.(type) {
 		case types.MsgCheckpoint:
-			return handleMsgCheckpoint(ctx, msg, k, contractCaller)
+			return handleMsgCheckpoint(ctx, msg, k)
 		case types.MsgCheckpointAck:
-			return handleMsgCheckpointAck(ctx, msg, k, contractCaller)
+			return handleMsgCheckpointAck(ctx, msg, k)
 		case types.MsgCheckpointNoAck:
 			return handleMsgCheckpointNoAck(ctx, msg, k)
 		default:
@@ -31,12 +31,14 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 	}
 }
 
-// handleMsgCheckpoint Validates checkpoint transaction
-func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+// handleMsgCheckpoint Validates checkpoint transaction and adds it to buffer to await ACK.
+// Root hash is not checked here (it requires bor rpc), validators check it before voting (see side handler).
+func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Validating checkpoint data", "TxData", msg)
 
-	if msg.TimeStamp == 0 || msg.TimeStamp > uint64(time.Now().UTC().Unix()) {
-		k.Logger(ctx).Error("Checkpoint timestamp must be in near past", "CurrentTime", time.Now().UTC().Unix(), "CheckpointTime", msg.TimeStamp, "Condition", msg.TimeStamp >= uint64(time.Now().UTC().Unix()))
+	blockTime := ctx.BlockTime().UTC()
+	if msg.TimeStamp == 0 || msg.TimeStamp > uint64(blockTime.Unix()) {
+		k.Logger(ctx).Error("Checkpoint timestamp must be in near past", "BlockTime", blockTime.Unix(), "CheckpointTime", msg.TimeStamp)
 		return common.ErrBadTimeStamp(k.Codespace()).Result()
 	}
 
@@ -44,40 +46,31 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 
 	checkpointBuffer, err := k.GetCheckpointFromBuffer(ctx)
 	if err == nil {
-		if msg.TimeStamp == 0 || checkpointBuffer.TimeStamp == 0 || ((msg.TimeStamp > checkpointBuffer.TimeStamp) && msg.TimeStamp-checkpointBuffer.TimeStamp >= uint64(params.CheckpointBufferTime.Seconds())) {
-			k.Logger(ctx).Debug("Checkpoint has been timed out, flushing buffer", "CheckpointTimestamp", msg.TimeStamp, "PrevCheckpointTimestamp", checkpointBuffer.TimeStamp)
+		// buffer expires by block time, like checkpoint timestamp is checked above
+		checkpointTime := time.Unix(int64(checkpointBuffer.TimeStamp), 0)
+		expiryTime := checkpointTime.Add(params.CheckpointBufferTime)
+		if checkpointBuffer.TimeStamp == 0 || !blockTime.Before(expiryTime) {
+			k.Logger(ctx).Debug("Checkpoint has been timed out, flushing buffer", "BlockTime", blockTime.Unix(), "PrevCheckpointTimestamp", checkpointBuffer.TimeStamp)
 			k.FlushCheckpointBuffer(ctx)
 		} else {
 			// calulates remaining time for buffer to be flushed
-			checkpointTime := time.Unix(int64(checkpointBuffer.TimeStamp), 0)
-			expiryTime := checkpointTime.Add(params.CheckpointBufferTime)
-			diff := expiryTime.Sub(time.Now().UTC()).Seconds()
+			diff := expiryTime.Sub(blockTime).Seconds()
 			k.Logger(ctx).Error("Checkpoint already exits in buffer", "Checkpoint", checkpointBuffer.String(), "Expires", expiryTime)
 			return common.ErrNoACK(k.Codespace(), diff).Result()
 		}
 	}
 	// k.Logger(ctx).Debug("Received checkpoint from buffer", "Checkpoint", checkpointBuffer.String())
 
-	// validate checkpoint
-	validCheckpoint, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash)
-	if err != nil {
-		k.Logger(ctx).Error("Error validating checkpoint",
-			"Error", err,
-			"StartBlock", msg.StartBlock,
-			"EndBlock", msg.EndBlock)
-		return common.ErrBadBlockDetails(k.Codespace()).Result()
-	}
-
-	if !validCheckpoint {
-		k.Logger(ctx).Error("RootHash is not valid",
-			"StartBlock", msg.StartBlock,
-			"EndBlock", msg.EndBlock,
-			"RootHash", msg.RootHash)
+	// make sure checkpoint length is within limits
+	checkpointLength := msg.EndBlock - msg.StartBlock + 1
+	if msg.EndBlock < msg.StartBlock || checkpointLength > params.MaxCheckpointLength {
+		k.Logger(ctx).Error("Checkpoint length exceeds max checkpoint length",
+			"startBlock", msg.StartBlock,
+			"endBlock", msg.EndBlock,
+			"maxCheckpointLength", params.MaxCheckpointLength)
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
 	}
 
-	k.Logger(ctx).Debug("Valid Roothash in checkpoint", "StartBlock", msg.StartBlock, "EndBlock", msg.EndBlock)
-
 	// fetch last checkpoint from store
 	if lastCheckpoint, err := k.GetLastCheckpoint(ctx); err == nil {
 		// make sure new checkpoint is after tip
@@ -93,6 +86,17 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 				"startBlock", msg.StartBlock)
 			return common.ErrDisCountinuousCheckpoint(k.Codespace()).Result()
 		}
+
+		// checkpoint shorter than avg length is allowed only after force push interval (block producers are down)
+		forcePushTime := time.Unix(int64(lastCheckpoint.TimeStamp), 0).Add(params.ForcePushInterval)
+		if checkpointLength < params.AvgCheckpointLength && time.Unix(int64(msg.TimeStamp), 0).Before(forcePushTime) {
+			k.Logger(ctx).Error("Checkpoint is shorter than avg checkpoint length",
+				"startBlock", msg.StartBlock,
+				"endBlock", msg.EndBlock,
+				"avgCheckpointLength", params.AvgCheckpointLength,
+				"forcePushTime", forcePushTime)
+			return common.ErrBadBlockDetails(k.Codespace()).Result()
+		}
 	} else if err.Error() == common.ErrNoCheckpointFound(k.Codespace()).Error() && msg.StartBlock != 0 {
 		k.Logger(ctx).Error("First checkpoint to start from block 1", "Error", err)
 		return common.ErrBadBlockDetails(k.Codespace()).Result()
@@ -124,7 +128,6 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	k.Logger(ctx).Debug("Valid proposer in checkpoint")
 
 	// add checkpoint to buffer
-	// Add AccountRootHash to CheckpointBuffer
 	k.SetCheckpointBuffer(ctx, hmTypes.CheckpointBlockHeader{
 		StartBlock:      msg.StartBlock,
 		EndBlock:        msg.EndBlock,
@@ -137,6 +140,11 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	checkpoint, _ := k.GetCheckpointFromBuffer(ctx)
 	k.Logger(ctx).Debug("Adding good checkpoint to buffer to await ACK", "checkpointStored", checkpoint.String())
 
+	// record proposal in proposer stats
+	k.UpdateProposerStats(ctx, msg.Proposer, func(stats *types.ProposerStats) {
+		stats.Proposals++
+	})
+
 	ctx.EventManager().EmitEvents(sdk.Events{
 		sdk.NewEvent(
 			types.EventTypeCheckpoint,
@@ -144,6 +152,7 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 			sdk.NewAttribute(types.AttributeKeyProposer, msg.Proposer.String()),
 			sdk.NewAttribute(types.AttributeKeyStartBlock, strconv.FormatUint(uint64(msg.StartBlock), 10)),
 			sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(uint64(msg.EndBlock), 10)),
+			sdk.NewAttribute(types.AttributeKeyRootHash, msg.RootHash.String()),
 		),
 	})
 
@@ -152,26 +161,19 @@ func handleMsgCheckpoint(ctx sdk.Context, msg types.MsgCheckpoint, k Keeper, con
 	}
 }
 
-// handleMsgCheckpointAck Validates if checkpoint submitted on chain is valid
-func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+// handleMsgCheckpointAck Validates if checkpoint submitted on chain is valid.
+// Header block details in msg are checked against rootchain by validators (see side handler).
+func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Validating Checkpoint ACK", "Tx", msg)
 
-	// make call to headerBlock with header number
-	root, start, end, createdAt, proposer, err := contractCaller.GetHeaderInfo(msg.HeaderBlock)
-	if err != nil {
-		k.Logger(ctx).Error("Unable to fetch header from rootchain contract", "Error", err, "headerBlockIndex", msg.HeaderBlock)
+	root, start, end := msg.RootHash, msg.StartBlock, msg.EndBlock
+
+	// make sure ack is for next header block
+	if expectedHeaderBlock := types.ChildBlockInterval * (k.GetACKCount(ctx) + 1); msg.HeaderBlock != expectedHeaderBlock {
+		k.Logger(ctx).Error("Invalid header block", "headerBlockExpected", expectedHeaderBlock, "headerBlockReceived", msg.HeaderBlock)
 		return common.ErrBadAck(k.Codespace()).Result()
 	}
 
-	k.Logger(ctx).Debug("HeaderBlock fetched",
-		"headerBlock", msg.HeaderBlock,
-		"start", start,
-		"end", end,
-		"roothash", root,
-		"proposer", proposer,
-		"createdAt", createdAt,
-	)
-
 	// get last checkpoint from buffer
 	headerBlock, err := k.GetCheckpointFromBuffer(ctx)
 	if err != nil {
@@ -191,17 +193,47 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 			"rootRecieved", root.String())
 		return common.ErrBadAck(k.Codespace()).Result()
 	}
+
+	// checkpoint on chain may be shorter than buffered one, or submitted by another proposer
+	bufferedEndBlock, bufferedProposer := headerBlock.EndBlock, headerBlock.Proposer
 	if headerBlock.EndBlock > end {
 		k.Logger(ctx).Info("Adjusting endBlock to one already submitted on chain", "OldEndBlock", headerBlock.EndBlock, "AdjustedEndBlock", end)
 		headerBlock.EndBlock = end
-		headerBlock.RootHash = hmTypes.HeimdallHash(root)
-		// TODO proposer also needs to be changed
+		headerBlock.RootHash = root
+	}
+	if !bytes.Equal(headerBlock.Proposer.Bytes(), msg.Proposer.Bytes()) {
+		k.Logger(ctx).Info("Adjusting proposer to one who submitted checkpoint on chain", "OldProposer", headerBlock.Proposer.String(), "AdjustedProposer", msg.Proposer.String())
+		headerBlock.Proposer = msg.Proposer
+	}
+
+	if headerBlock.EndBlock != bufferedEndBlock || !bytes.Equal(headerBlock.Proposer.Bytes(), bufferedProposer.Bytes()) {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeCheckpointAdjust,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(msg.HeaderBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeyProposer, bufferedProposer.String()),
+				sdk.NewAttribute(types.AttributeKeyNewProposer, headerBlock.Proposer.String()),
+				sdk.NewAttribute(types.AttributeKeyEndBlock, strconv.FormatUint(bufferedEndBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeyNewEndBlock, strconv.FormatUint(headerBlock.EndBlock, 10)),
+				sdk.NewAttribute(types.AttributeKeyRootHash, headerBlock.RootHash.String()),
+			),
+		)
 	}
 
 	// Add checkpoint to headerBlocks
 	k.AddCheckpoint(ctx, msg.HeaderBlock, *headerBlock)
 	k.Logger(ctx).Info("Checkpoint added to store", "headerBlock", headerBlock.String())
 
+	// record ack in proposer stats, with time from checkpoint to its ack
+	var ackTime uint64
+	if blockTime := uint64(ctx.BlockTime().Unix()); blockTime > headerBlock.TimeStamp {
+		ackTime = blockTime - headerBlock.TimeStamp
+	}
+	k.UpdateProposerStats(ctx, headerBlock.Proposer, func(stats *types.ProposerStats) {
+		stats.AddAck(ackTime)
+	})
+
 	// flush buffer
 	k.FlushCheckpointBuffer(ctx)
 	k.Logger(ctx).Debug("Checkpoint buffer flushed after receiving checkpoint ack", "checkpoint", headerBlock)
@@ -210,10 +242,15 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 	k.UpdateACKCount(ctx)
 	k.Logger(ctx).Debug("Valid ack received", "CurrentACKCount", k.GetACKCount(ctx)-1, "UpdatedACKCount", k.GetACKCount(ctx))
 
+	// record validator set which signed checkpoint, before proposer rotates
+	// (header block is header index on rootchain, checkpoint number is header index / child block interval)
+	k.sk.SetCheckpointValidatorSet(ctx, msg.HeaderBlock/types.ChildBlockInterval)
+
 	// --- Update to new proposer
 
 	// increment accum
 	k.sk.IncrementAccum(ctx, 1)
+	k.sk.SetValidatorSetSnapshot(ctx)
 
 	//log new proposer
 	vs := k.sk.GetValidatorSet(ctx)
@@ -230,6 +267,7 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 			types.EventTypeCheckpointAck,
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(types.AttributeKeyHeaderIndex, strconv.FormatUint(uint64(msg.HeaderBlock), 10)),
+			sdk.NewAttribute(types.AttributeKeyProposer, headerBlock.Proposer.String()),
 		),
 	})
 
@@ -241,6 +279,11 @@ func handleMsgCheckpointAck(ctx sdk.Context, msg types.MsgCheckpointAck, k Keepe
 // Validate checkpoint no-ack transaction
 func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Validating checkpoint no-ack", "TxData", msg)
+	if msg.TimeStamp > uint64(ctx.BlockTime().Unix()) {
+		k.Logger(ctx).Error("No-ack timestamp must be in near past", "BlockTime", ctx.BlockTime().Unix(), "NoAckTime", msg.TimeStamp)
+		return common.ErrBadTimeStamp(k.Codespace()).Result()
+	}
+
 	// current time
 	currentTime := time.Unix(int64(msg.TimeStamp), 0) // buffer time
 	bufferTime := k.GetParams(ctx).CheckpointBufferTime
@@ -269,10 +312,21 @@ func handleMsgCheckpointNoAck(ctx sdk.Context, msg types.MsgCheckpointNoAck, k K
 	k.SetLastNoAck(ctx, uint64(currentTime.Unix()))
 	k.Logger(ctx).Debug("Last No-ACK time set", "LastNoAck", k.GetLastNoAck(ctx))
 
+	// drop checkpoints still awaiting votes, they were proposed by proposer which is rotated out
+	k.moduleCommunicator.RemovePendingSideTxs(ctx, types.RouterKey, types.MsgCheckpoint{}.Type())
+
+	// record no-ack against proposer which is rotated out
+	if proposer := k.sk.GetValidatorSet(ctx).Proposer; proposer != nil {
+		k.UpdateProposerStats(ctx, proposer.Signer, func(stats *types.ProposerStats) {
+			stats.NoAcks++
+		})
+	}
+
 	// --- Update to new proposer
 
 	// increment accum
 	k.sk.IncrementAccum(ctx, 1)
+	k.sk.SetValidatorSetSnapshot(ctx)
 
 	//log new proposer
 	vs := k.sk.GetValidatorSet(ctx)
@@ -237,7 +237,7 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 			header.RootHash,
 			header.RewardRootHash,
 			header.TimeStamp) // send checkpoint to handler
-		got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+		got := handleMsgCheckpoint(ctx, msgCheckpoint, ck)
 		require.True(t, !got.IsOK(), "expected send-checkpoint to be not ok, got %v", got.IsOK())
 	})
 
@@ -269,7 +269,7 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 			header.RewardRootHash = types.BytesToHeimdallHash(genesisrewardRootHash)
 			msgCheckpoint := NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.RewardRootHash, header.TimeStamp)
 			// send new checkpoint which should replace old one
-			got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+			got := handleMsgCheckpoint(ctx, msgCheckpoint, ck)
 			require.True(t, got.IsOK(), "expected send-checkpoint to be  ok, got %v", got)
 		})
 
@@ -296,7 +296,7 @@ func TestHandleMsgCheckpoint(t *testing.T) {
 			msgCheckpoint := NewMsgCheckpointBlock(header.Proposer, header.StartBlock, header.EndBlock, header.RootHash, header.RewardRootHash, uint64(time.Now().Unix()))
 
 			// send checkpoint to handler
-			got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+			got := handleMsgCheckpoint(ctx, msgCheckpoint, ck)
 			require.True(t, !got.IsOK(), "expected send-checkpoint to be not ok, got %v", got)
 		})
 	})
@@ -317,7 +317,7 @@ func SentValidCheckpoint(header types.CheckpointBlockHeader, ck Keeper, sk staki
 		header.RewardRootHash,
 		header.TimeStamp)
 	// send checkpoint to handler
-	got := handleMsgCheckpoint(ctx, msgCheckpoint, ck, &contractCallerObj)
+	got := handleMsgCheckpoint(ctx, msgCheckpoint, ck)
 	require.True(t, got.IsOK(), "expected send-checkpoint to be ok, got %v", got)
 	storedHeader, err := ck.GetCheckpointFromBuffer(ctx)
 	t.Log("Header added to buffer", storedHeader.String())
@@ -1,6 +1,7 @@
 package checkpoint
 
 import (
+	"encoding/binary"
 	"errors"
 	"strconv"
 
@@ -10,7 +11,6 @@ import (
 
 	"github.com/maticnetwork/heimdall/checkpoint/types"
 	cmn "github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params/subspace"
 	"github.com/maticnetwork/heimdall/staking"
 	hmTypes "github.com/maticnetwork/heimdall/types"
@@ -23,13 +23,24 @@ var (
 	BufferCheckpointKey = []byte{0x12} // Key to store checkpoint in buffer
 	HeaderBlockKey      = []byte{0x13} // prefix key for when storing header after ACK
 	LastNoACKKey        = []byte{0x14} // key to store last no-ack
+
+	CheckpointEndBlockKey = []byte{0x17} // prefix key for header index of checkpoint by its end block
+	ProposerStatsKey      = []byte{0x18} // prefix key for checkpoint proposer stats of validators
 )
 
+// ModuleCommunicator manager to access side txs of checkpoint module
+type ModuleCommunicator interface {
+	// RemovePendingSideTxs removes pending side txs of given msg route and type
+	RemovePendingSideTxs(ctx sdk.Context, route string, msgType string)
+}
+
 // Keeper stores all related data
 type Keeper struct {
 	cdc *codec.Codec
 	// staking keeper
 	sk staking.Keeper
+	// module communicator
+	moduleCommunicator ModuleCommunicator
 	// The (unexposed) keys used to access the stores from the Context.
 	storeKey sdk.StoreKey
 	// codespace
@@ -45,13 +56,15 @@ func NewKeeper(
 	paramSpace subspace.Subspace,
 	codespace sdk.CodespaceType,
 	stakingKeeper staking.Keeper,
+	moduleCommunicator ModuleCommunicator,
 ) Keeper {
 	keeper := Keeper{
-		cdc:        cdc,
-		storeKey:   storeKey,
-		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
-		codespace:  codespace,
-		sk:         stakingKeeper,
+		cdc:                cdc,
+		storeKey:           storeKey,
+		paramSpace:         paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:          codespace,
+		sk:                 stakingKeeper,
+		moduleCommunicator: moduleCommunicator,
 	}
 	return keeper
 }
@@ -73,6 +86,10 @@ func (k *Keeper) AddCheckpoint(ctx sdk.Context, headerBlockNumber uint64, header
 	if err != nil {
 		return err
 	}
+
+	// index checkpoint by end block
+	ctx.KVStore(k.storeKey).Set(GetCheckpointEndBlockKey(headerBlock.EndBlock), sdk.Uint64ToBigEndian(headerBlockNumber))
+
 	k.Logger(ctx).Info("Adding good checkpoint to state", "checkpoint", headerBlock, "headerBlockNumber", headerBlockNumber)
 	return nil
 }
@@ -147,13 +164,57 @@ func (k *Keeper) GetCheckpointList(ctx sdk.Context, page uint64, limit uint64) (
 	return headers, nil
 }
 
+// GetCheckpointEndBlockKey appends prefix to end block
+func GetCheckpointEndBlockKey(endBlock uint64) []byte {
+	return append(CheckpointEndBlockKey, sdk.Uint64ToBigEndian(endBlock)...)
+}
+
+// GetCheckpointByBlockNumber returns header index and checkpoint which contains bor block
+func (k *Keeper) GetCheckpointByBlockNumber(ctx sdk.Context, blockNumber uint64) (uint64, hmTypes.CheckpointBlockHeader, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	// first checkpoint ending at or after block
+	iterator := store.Iterator(GetCheckpointEndBlockKey(blockNumber), sdk.PrefixEndBytes(CheckpointEndBlockKey))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, hmTypes.CheckpointBlockHeader{}, cmn.ErrNoCheckpointFound(k.Codespace())
+	}
+
+	headerIndex := binary.BigEndian.Uint64(iterator.Value())
+	checkpoint, err := k.GetCheckpointByIndex(ctx, headerIndex)
+	if err != nil {
+		return 0, checkpoint, err
+	}
+
+	if blockNumber < checkpoint.StartBlock {
+		return 0, hmTypes.CheckpointBlockHeader{}, cmn.ErrNoCheckpointFound(k.Codespace())
+	}
+
+	return headerIndex, checkpoint, nil
+}
+
+// GetLastCheckpointedBlock returns last bor block covered by checkpoints, and header index of its checkpoint
+func (k *Keeper) GetLastCheckpointedBlock(ctx sdk.Context) (blockNumber uint64, headerIndex uint64, found bool) {
+	iterator := sdk.KVStoreReversePrefixIterator(ctx.KVStore(k.storeKey), CheckpointEndBlockKey)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, 0, false
+	}
+
+	blockNumber = binary.BigEndian.Uint64(iterator.Key()[len(CheckpointEndBlockKey):])
+	headerIndex = binary.BigEndian.Uint64(iterator.Value())
+	return blockNumber, headerIndex, true
+}
+
 // GetLastCheckpoint gets last checkpoint, headerIndex = TotalACKs * ChildBlockInterval
 func (k *Keeper) GetLastCheckpoint(ctx sdk.Context) (hmTypes.CheckpointBlockHeader, error) {
 	store := ctx.KVStore(k.storeKey)
 	acksCount := k.GetACKCount(ctx)
 
 	// fetch last checkpoint key (NumberOfACKs * ChildBlockInterval)
-	lastCheckpointKey := helper.GetConfig().ChildBlockInterval * acksCount
+	lastCheckpointKey := types.ChildBlockInterval * acksCount
 
 	// fetch checkpoint and unmarshall
 	var _checkpoint hmTypes.CheckpointBlockHeader
@@ -255,6 +316,70 @@ func (k *Keeper) GetCheckpointHeaders(ctx sdk.Context) []hmTypes.CheckpointBlock
 	return headers
 }
 
+//
+// Proposer stats
+//
+
+// GetProposerStatsKey appends prefix to validator id
+func GetProposerStatsKey(validatorID hmTypes.ValidatorID) []byte {
+	return append(ProposerStatsKey, validatorID.Bytes()...)
+}
+
+// SetProposerStats stores checkpoint proposer stats of validator
+func (k *Keeper) SetProposerStats(ctx sdk.Context, stats types.ProposerStats) error {
+	out, err := k.cdc.MarshalBinaryBare(stats)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling proposer stats", "error", err)
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(GetProposerStatsKey(stats.ValidatorID), out)
+	return nil
+}
+
+// GetProposerStats returns checkpoint proposer stats of validator, empty stats if validator never proposed
+func (k *Keeper) GetProposerStats(ctx sdk.Context, validatorID hmTypes.ValidatorID) types.ProposerStats {
+	store := ctx.KVStore(k.storeKey)
+	key := GetProposerStatsKey(validatorID)
+
+	stats := types.NewProposerStats(validatorID)
+	if store.Has(key) {
+		if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &stats); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling proposer stats", "validatorID", validatorID, "error", err)
+		}
+	}
+	return stats
+}
+
+// GetAllProposerStats returns checkpoint proposer stats of all validators
+func (k *Keeper) GetAllProposerStats(ctx sdk.Context) (result []types.ProposerStats) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), ProposerStatsKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var stats types.ProposerStats
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &stats); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling proposer stats", "error", err)
+			continue
+		}
+		result = append(result, stats)
+	}
+	return result
+}
+
+// UpdateProposerStats applies update to checkpoint proposer stats of validator with given signer
+func (k *Keeper) UpdateProposerStats(ctx sdk.Context, signer hmTypes.HeimdallAddress, update func(stats *types.ProposerStats)) {
+	validator, err := k.sk.GetValidatorInfo(ctx, signer.Bytes())
+	if err != nil {
+		k.Logger(ctx).Error("Unable to find validator for proposer stats", "signer", signer.String(), "error", err)
+		return
+	}
+
+	stats := k.GetProposerStats(ctx, validator.ID)
+	update(&stats)
+	k.SetProposerStats(ctx, stats)
+}
+
 //
 // Ack count
 //
@@ -115,7 +115,7 @@ func (AppModule) Route() string {
 
 // NewHandler returns an sdk.Handler for the module.
 func (am AppModule) NewHandler() sdk.Handler {
-	return NewHandler(am.keeper, am.contractCaller)
+	return NewHandler(am.keeper)
 }
 
 // QuerierRoute returns the auth module's querier route name.
@@ -168,10 +168,10 @@ func verifyGenesis(state types.GenesisState) error {
 		return nil
 	}
 
-	if state.AckCount*helper.GetConfig().ChildBlockInterval != currentHeaderIndex {
+	if state.AckCount*types.ChildBlockInterval != currentHeaderIndex {
 		fmt.Println("Header Count doesn't match",
 			"ExpectedHeader", currentHeaderIndex,
-			"HeaderIndexFound", state.AckCount*helper.GetConfig().ChildBlockInterval)
+			"HeaderIndexFound", state.AckCount*types.ChildBlockInterval)
 		return nil
 	}
 
@@ -180,7 +180,7 @@ func verifyGenesis(state types.GenesisState) error {
 	// check all headers
 	for i, header := range state.Headers {
 		ackCount := uint64(i + 1)
-		root, start, end, _, _, err := contractCaller.GetHeaderInfo(ackCount * helper.GetConfig().ChildBlockInterval)
+		root, start, end, _, _, err := contractCaller.GetHeaderInfo(ackCount * types.ChildBlockInterval)
 		if err != nil {
 			return err
 		}
@@ -28,6 +28,14 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryLastNoAck(ctx, req, keeper)
 		case types.QueryCheckpointList:
 			return handleQueryCheckpointList(ctx, req, keeper)
+		case types.QueryCheckpointByBlock:
+			return handleQueryCheckpointByBlock(ctx, req, keeper)
+		case types.QueryLastCheckpointedBlock:
+			return handleQueryLastCheckpointedBlock(ctx, req, keeper)
+		case types.QueryProposerStats:
+			return handleQueryProposerStats(ctx, req, keeper)
+		case types.QueryAllProposerStats:
+			return handleQueryAllProposerStats(ctx, req, keeper)
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown auth query endpoint")
 		}
@@ -68,6 +76,66 @@ func handleQueryCheckpoint(ctx sdk.Context, req abci.RequestQuery, keeper Keeper
 	return bz, nil
 }
 
+func handleQueryCheckpointByBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryBlockParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	headerIndex, checkpoint, err := keeper.GetCheckpointByBlockNumber(ctx, params.BlockNumber)
+	if err != nil {
+		// no checkpoint contains block
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(types.BlockCheckpoint{
+		HeaderIndex:           headerIndex,
+		CheckpointBlockHeader: checkpoint,
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryLastCheckpointedBlock(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	blockNumber, headerIndex, found := keeper.GetLastCheckpointedBlock(ctx)
+	if !found {
+		// no checkpoint yet
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(types.LastCheckpointedBlock{
+		BlockNumber: blockNumber,
+		HeaderIndex: headerIndex,
+	})
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryProposerStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	bz, err := json.Marshal(keeper.GetProposerStats(ctx, params.ValidatorID))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryAllProposerStats(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetAllProposerStats(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
 func handleQueryCheckpointBuffer(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
 	res, err := keeper.GetCheckpointFromBuffer(ctx)
 	if err != nil {
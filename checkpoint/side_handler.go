@@ -0,0 +1,71 @@
+package checkpoint
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var sideTxLogger = helper.Logger.With("module", "checkpoint/side")
+
+// NewSideTxHandler returns handler which validates checkpoint msgs against bor chain and rootchain.
+// It is run by validators outside of consensus (see sidechannel).
+func NewSideTxHandler(contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(msg sdk.Msg) (bool, error) {
+		switch msg := msg.(type) {
+		case types.MsgCheckpoint:
+			return SideHandleMsgCheckpoint(msg)
+		case types.MsgCheckpointAck:
+			return SideHandleMsgCheckpointAck(msg, contractCaller)
+		default:
+			return false, nil
+		}
+	}
+}
+
+// SideHandleMsgCheckpoint validates checkpoint root hash against headers on bor chain
+func SideHandleMsgCheckpoint(msg types.MsgCheckpoint) (bool, error) {
+	// blocks might not be available on bor node yet, retried on next poll
+	valid, err := types.ValidateCheckpoint(msg.StartBlock, msg.EndBlock, msg.RootHash)
+	if err != nil {
+		return false, err
+	}
+
+	if !valid {
+		sideTxLogger.Error("Checkpoint root hash doesn't match bor headers",
+			"startBlock", msg.StartBlock,
+			"endBlock", msg.EndBlock,
+			"rootReceived", msg.RootHash.String())
+	}
+
+	return valid, nil
+}
+
+// SideHandleMsgCheckpointAck validates checkpoint ack against header block on rootchain
+func SideHandleMsgCheckpointAck(msg types.MsgCheckpointAck, contractCaller helper.IContractCaller) (bool, error) {
+	// make call to headerBlock with header number, retried on rpc errors
+	root, start, end, _, proposer, err := contractCaller.GetHeaderInfo(msg.HeaderBlock)
+	if err != nil {
+		return false, err
+	}
+
+	if start != msg.StartBlock || end != msg.EndBlock || !bytes.Equal(root.Bytes(), msg.RootHash.Bytes()) || !bytes.Equal(proposer.Bytes(), msg.Proposer.Bytes()) {
+		sideTxLogger.Error("Checkpoint ack msg doesn't match header block",
+			"headerBlock", msg.HeaderBlock,
+			"startExpected", start,
+			"startReceived", msg.StartBlock,
+			"endExpected", end,
+			"endReceived", msg.EndBlock,
+			"rootExpected", root.String(),
+			"rootReceived", msg.RootHash.String(),
+			"proposerExpected", proposer.String(),
+			"proposerReceived", msg.Proposer.String())
+		return false, nil
+	}
+
+	return true, nil
+}
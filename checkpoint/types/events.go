@@ -2,15 +2,18 @@ package types
 
 // Checkpoint tags
 var (
-	EventTypeCheckpoint      = "checkpoint"
-	EventTypeCheckpointAck   = "checkpoint-ack"
-	EventTypeCheckpointNoAck = "checkpoint-noack"
+	EventTypeCheckpoint       = "checkpoint"
+	EventTypeCheckpointAck    = "checkpoint-ack"
+	EventTypeCheckpointAdjust = "checkpoint-adjust"
+	EventTypeCheckpointNoAck  = "checkpoint-noack"
 
 	AttributeKeyProposer    = "proposer"
 	AttributeKeyStartBlock  = "start-block"
 	AttributeKeyEndBlock    = "end-block"
 	AttributeKeyHeaderIndex = "header-index"
 	AttributeKeyNewProposer = "new-proposer"
+	AttributeKeyNewEndBlock = "new-end-block"
+	AttributeKeyRootHash    = "root-hash"
 
 	AttributeValueCategory = ModuleName
 )
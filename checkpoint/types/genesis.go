@@ -16,6 +16,7 @@ type GenesisState struct {
 	LastNoACK          uint64                          `json:"last_no_ack" yaml:"last_no_ack"`
 	AckCount           uint64                          `json:"ack_count" yaml:"ack_count"`
 	Headers            []hmTypes.CheckpointBlockHeader `json:"headers" yaml:"headers"`
+	ProposerStats      []ProposerStats                 `json:"proposer_stats" yaml:"proposer_stats"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -25,6 +26,7 @@ func NewGenesisState(
 	lastNoACK uint64,
 	ackCount uint64,
 	headers []hmTypes.CheckpointBlockHeader,
+	proposerStats []ProposerStats,
 ) GenesisState {
 	return GenesisState{
 		Params:             params,
@@ -32,6 +34,7 @@ func NewGenesisState(
 		LastNoACK:          lastNoACK,
 		AckCount:           ackCount,
 		Headers:            headers,
+		ProposerStats:      proposerStats,
 	}
 }
 
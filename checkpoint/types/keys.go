@@ -15,4 +15,9 @@ const (
 
 	// DefaultParamspace default name for parameter store
 	DefaultParamspace = ModuleName
+
+	// ChildBlockInterval difference between header indexes of 2 checkpoints. It is hardcoded in RootChain
+	// contract, so unlike other checkpoint sizing rules it is neither a module param (a param change could
+	// only break header index mapping) nor a local config value (`child_chain_block_interval` is removed).
+	ChildBlockInterval uint64 = 10000
 )
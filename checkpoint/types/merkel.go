@@ -57,7 +57,41 @@ func CheckIfBlocksExist(end uint64) bool {
 	return true
 }
 
+// GetHeaders returns root hash of bor block headers from start to end
 func GetHeaders(start uint64, end uint64) ([]byte, error) {
+	headers, err := getHeaderLeaves(start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
+	if err := tree.Generate(convert(headers), sha3.NewLegacyKeccak256()); err != nil {
+		return nil, err
+	}
+
+	return tree.Root().Hash, nil
+}
+
+// GetBlockProof returns leaf of block in checkpoint tree from start to end, and its merkle proof
+// (concatenated siblings from leaf to root) in the format RootChain contract verifies
+func GetBlockProof(start uint64, end uint64, blockNumber uint64) (leaf []byte, proof []byte, err error) {
+	if blockNumber < start || blockNumber > end {
+		return nil, nil, errors.New("block is not in checkpoint range")
+	}
+
+	headers, err := getHeaderLeaves(start, end)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	index := blockNumber - start
+	_, proof = getMerkleProof(headers, index)
+	return headers[index][:], proof, nil
+}
+
+// getHeaderLeaves fetches bor block headers from start to end, and returns their leaves
+// padded with empty leaves to next power of two
+func getHeaderLeaves(start uint64, end uint64) ([][32]byte, error) {
 	rpcClient := helper.GetMaticRPCClient()
 
 	if start > end {
@@ -105,12 +139,24 @@ func GetHeaders(start uint64, end uint64) ([]byte, error) {
 		headers[i] = arr
 	}
 
-	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
-	if err := tree.Generate(convert(headers), sha3.NewLegacyKeccak256()); err != nil {
-		return nil, err
-	}
+	return headers, nil
+}
 
-	return tree.Root().Hash, nil
+// getMerkleProof returns root and proof of leaf at index, for leaves count of power of two
+func getMerkleProof(leaves [][32]byte, index uint64) (root []byte, proof []byte) {
+	level := leaves
+	for len(level) > 1 {
+		proof = append(proof, level[index^1][:]...)
+
+		next := make([][32]byte, len(level)/2)
+		for i := range next {
+			copy(next[i][:], crypto.Keccak256(level[2*i][:], level[2*i+1][:]))
+		}
+
+		level = next
+		index = index / 2
+	}
+	return level[0][:], proof
 }
 
 // GetAccountRootHash returns roothash of Validator Account State Tree
@@ -231,7 +277,7 @@ func nextPowerOfTwo(n uint64) uint64 {
 
 // spins go-routines to fetch batch elements to allow creation of large merkle trees
 func fetchBatchElements(rpcClient *rpc.Client, elements []rpc.BatchElem) (err error) {
-	var batchLength = int(helper.GetConfig().AvgCheckpointLength)
+	var batchLength = int(DefaultAvgCheckpointLength)
 	// group
 	var g errgroup.Group
 
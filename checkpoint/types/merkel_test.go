@@ -0,0 +1,37 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/crypto/sha3"
+	"github.com/xsleonard/go-merkle"
+)
+
+func TestGetMerkleProof(t *testing.T) {
+	leaves := make([][32]byte, nextPowerOfTwo(5))
+	for i := 0; i < 5; i++ {
+		copy(leaves[i][:], crypto.Keccak256([]byte{byte(i)}))
+	}
+
+	tree := merkle.NewTreeWithOpts(merkle.TreeOptions{EnableHashSorting: false, DisableHashLeaves: true})
+	require.NoError(t, tree.Generate(convert(leaves), sha3.NewLegacyKeccak256()))
+
+	for index := uint64(0); index < 5; index++ {
+		root, proof := getMerkleProof(leaves, index)
+		require.Equal(t, tree.Root().Hash, root)
+		require.Len(t, proof, 3*32)
+
+		// verify proof the way RootChain contract does
+		computed := leaves[index][:]
+		for i, position := 0, index; i < len(proof); i, position = i+32, position/2 {
+			if position%2 == 0 {
+				computed = crypto.Keccak256(computed, proof[i:i+32])
+			} else {
+				computed = crypto.Keccak256(proof[i:i+32], computed)
+			}
+		}
+		require.Equal(t, root, computed)
+	}
+}
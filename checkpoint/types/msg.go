@@ -2,7 +2,6 @@ package types
 
 import (
 	"bytes"
-	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -16,6 +15,7 @@ import (
 //
 
 var _ sdk.Msg = &MsgCheckpoint{}
+var _ types.ProposerSideTxMsg = MsgCheckpoint{}
 
 // MsgCheckpoint represents checkpoint
 type MsgCheckpoint struct {
@@ -77,31 +77,70 @@ func (msg MsgCheckpoint) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.Proposer.String())
 	}
 
-	if msg.StartBlock >= msg.EndBlock || msg.EndBlock == 0 {
+	if msg.StartBlock > msg.EndBlock || msg.EndBlock == 0 {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid startBlock %v or/and endBlock %v", msg.StartBlock, msg.EndBlock)
 	}
 
 	return nil
 }
 
+// GetProposer Returns proposer of checkpoint, who has to be current proposer of validator set
+func (msg MsgCheckpoint) GetProposer() types.HeimdallAddress {
+	return msg.Proposer
+}
+
+// GetTxHash Returns root hash, checkpoint is not mainchain event so it is identified by its root hash and start block
+func (msg MsgCheckpoint) GetTxHash() types.HeimdallHash {
+	return msg.RootHash
+}
+
+// GetLogIndex Returns start block
+func (msg MsgCheckpoint) GetLogIndex() uint64 {
+	return msg.StartBlock
+}
+
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgCheckpoint) GetSideSignBytes() []byte {
+	msg.Proposer = types.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
 //
 // Msg Checkpoint Ack
 //
 
 var _ sdk.Msg = &MsgCheckpointAck{}
+var _ types.SideTxMsg = MsgCheckpointAck{}
 
 // MsgCheckpointAck Add mainchain commit transaction hash to MsgCheckpointAck
 type MsgCheckpointAck struct {
 	From        types.HeimdallAddress `json:"from"`
 	HeaderBlock uint64                `json:"headerBlock"`
+	Proposer    types.HeimdallAddress `json:"proposer"`
+	StartBlock  uint64                `json:"start_block"`
+	EndBlock    uint64                `json:"end_block"`
+	RootHash    types.HeimdallHash    `json:"root_hash"`
 	TxHash      types.HeimdallHash    `json:"tx_hash"`
 	LogIndex    uint64                `json:"log_index"`
 }
 
-func NewMsgCheckpointAck(from types.HeimdallAddress, headerBlock uint64, txHash types.HeimdallHash, logIndex uint64) MsgCheckpointAck {
+func NewMsgCheckpointAck(
+	from types.HeimdallAddress,
+	headerBlock uint64,
+	proposer types.HeimdallAddress,
+	startBlock uint64,
+	endBlock uint64,
+	rootHash types.HeimdallHash,
+	txHash types.HeimdallHash,
+	logIndex uint64,
+) MsgCheckpointAck {
 	return MsgCheckpointAck{
 		From:        from,
 		HeaderBlock: headerBlock,
+		Proposer:    proposer,
+		StartBlock:  startBlock,
+		EndBlock:    endBlock,
+		RootHash:    rootHash,
 		TxHash:      txHash,
 		LogIndex:    logIndex,
 	}
@@ -132,9 +171,12 @@ func (msg MsgCheckpointAck) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
 	}
 
-	childBlockInterval := helper.GetConfig().ChildBlockInterval
-	if msg.HeaderBlock > 0 && msg.HeaderBlock%childBlockInterval != 0 {
-		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid header block %d", msg.HeaderBlock)
+	if msg.Proposer.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.Proposer.String())
+	}
+
+	if msg.EndBlock < msg.StartBlock {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "End block %d is before start block %d", msg.EndBlock, msg.StartBlock)
 	}
 
 	return nil
@@ -150,6 +192,12 @@ func (msg MsgCheckpointAck) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
 
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgCheckpointAck) GetSideSignBytes() []byte {
+	msg.From = types.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
 //
 // Msg Checkpoint No Ack
 //
@@ -189,7 +237,8 @@ func (msg MsgCheckpointNoAck) GetSignBytes() []byte {
 }
 
 func (msg MsgCheckpointNoAck) ValidateBasic() sdk.Error {
-	if msg.TimeStamp == 0 || msg.TimeStamp > uint64(time.Now().UTC().Unix()) {
+	// timestamp is checked against block time in handler
+	if msg.TimeStamp == 0 {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid timestamp %d", msg.TimeStamp)
 	}
 
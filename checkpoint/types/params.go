@@ -12,26 +12,42 @@ import (
 // Default parameter values
 const (
 	DefaultCheckpointBufferTime time.Duration = 1000 * time.Second // Time checkpoint is allowed to stay in buffer (1000 seconds ~ 17 mins)
+	DefaultAvgCheckpointLength  uint64        = 256                // checkpoint number starts with 0, so length = defaultCheckpointLength -1
+	DefaultMaxCheckpointLength  uint64        = 1024               // max blocks in one checkpoint
+	DefaultForcePushInterval    time.Duration = 2048 * time.Second // Time after which shorter checkpoint is pushed if block producers are down
 )
 
 // Parameter keys
 var (
 	KeyCheckpointBufferTime = []byte("CheckpointBufferTime")
+	KeyAvgCheckpointLength  = []byte("AvgCheckpointLength")
+	KeyMaxCheckpointLength  = []byte("MaxCheckpointLength")
+	KeyForcePushInterval    = []byte("ForcePushInterval")
 )
 
 var _ subspace.ParamSet = &Params{}
 
-// Params defines the parameters for the auth module.
+// Params defines the parameters for the checkpoint module.
+// Child block interval is not a param, it is fixed by RootChain contract (see ChildBlockInterval).
 type Params struct {
 	CheckpointBufferTime time.Duration `json:"checkpoint_buffer_time" yaml:"checkpoint_buffer_time"`
+	AvgCheckpointLength  uint64        `json:"avg_checkpoint_length" yaml:"avg_checkpoint_length"` // average number of blocks checkpoint would contain
+	MaxCheckpointLength  uint64        `json:"max_checkpoint_length" yaml:"max_checkpoint_length"` // maximum number of blocks checkpoint would contain
+	ForcePushInterval    time.Duration `json:"force_push_interval" yaml:"force_push_interval"`     // time after which checkpoint shorter than average is allowed
 }
 
 // NewParams creates a new Params object
 func NewParams(
 	checkpointBufferTime time.Duration,
+	avgCheckpointLength uint64,
+	maxCheckpointLength uint64,
+	forcePushInterval time.Duration,
 ) Params {
 	return Params{
 		CheckpointBufferTime: checkpointBufferTime,
+		AvgCheckpointLength:  avgCheckpointLength,
+		MaxCheckpointLength:  maxCheckpointLength,
+		ForcePushInterval:    forcePushInterval,
 	}
 }
 
@@ -46,6 +62,9 @@ func ParamKeyTable() subspace.KeyTable {
 func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
 	return subspace.ParamSetPairs{
 		{KeyCheckpointBufferTime, &p.CheckpointBufferTime},
+		{KeyAvgCheckpointLength, &p.AvgCheckpointLength},
+		{KeyMaxCheckpointLength, &p.MaxCheckpointLength},
+		{KeyForcePushInterval, &p.ForcePushInterval},
 	}
 }
 
@@ -60,6 +79,9 @@ func (p Params) Equal(p2 Params) bool {
 func DefaultParams() Params {
 	return Params{
 		CheckpointBufferTime: DefaultCheckpointBufferTime,
+		AvgCheckpointLength:  DefaultAvgCheckpointLength,
+		MaxCheckpointLength:  DefaultMaxCheckpointLength,
+		ForcePushInterval:    DefaultForcePushInterval,
 	}
 }
 
@@ -68,10 +90,25 @@ func (p Params) String() string {
 	var sb strings.Builder
 	sb.WriteString("Params: \n")
 	sb.WriteString(fmt.Sprintf("CheckpointBufferTime: %s\n", p.CheckpointBufferTime))
+	sb.WriteString(fmt.Sprintf("AvgCheckpointLength: %d\n", p.AvgCheckpointLength))
+	sb.WriteString(fmt.Sprintf("MaxCheckpointLength: %d\n", p.MaxCheckpointLength))
+	sb.WriteString(fmt.Sprintf("ForcePushInterval: %s\n", p.ForcePushInterval))
 	return sb.String()
 }
 
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
+	if p.AvgCheckpointLength == 0 {
+		return fmt.Errorf("invalid avg checkpoint length: %d", p.AvgCheckpointLength)
+	}
+
+	if p.MaxCheckpointLength < p.AvgCheckpointLength {
+		return fmt.Errorf("max checkpoint length %d must not be less than avg checkpoint length %d", p.MaxCheckpointLength, p.AvgCheckpointLength)
+	}
+
+	if p.ForcePushInterval <= 0 {
+		return fmt.Errorf("invalid force push interval: %s", p.ForcePushInterval)
+	}
+
 	return nil
 }
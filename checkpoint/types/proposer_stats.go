@@ -0,0 +1,43 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ProposerStats checkpoint performance of validator as proposer
+type ProposerStats struct {
+	ValidatorID  hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	Proposals    uint64              `json:"proposals" yaml:"proposals"`           // checkpoints proposed
+	Acks         uint64              `json:"acks" yaml:"acks"`                     // checkpoints acked on mainchain
+	NoAcks       uint64              `json:"no_acks" yaml:"no_acks"`               // no-acks which rotated validator out as proposer
+	TotalAckTime uint64              `json:"total_ack_time" yaml:"total_ack_time"` // total time (in seconds) from checkpoint to its ack
+	AvgAckTime   uint64              `json:"avg_ack_time" yaml:"avg_ack_time"`     // average time (in seconds) from checkpoint to its ack
+}
+
+// NewProposerStats creates empty stats for validator
+func NewProposerStats(validatorID hmTypes.ValidatorID) ProposerStats {
+	return ProposerStats{
+		ValidatorID: validatorID,
+	}
+}
+
+// AddAck records ack of checkpoint which took given time (in seconds) to land
+func (s *ProposerStats) AddAck(ackTime uint64) {
+	s.Acks++
+	s.TotalAckTime += ackTime
+	s.AvgAckTime = s.TotalAckTime / s.Acks
+}
+
+// String returns human readable stats
+func (s ProposerStats) String() string {
+	return fmt.Sprintf(
+		"ProposerStats{%v %v %v %v %v}",
+		s.ValidatorID,
+		s.Proposals,
+		s.Acks,
+		s.NoAcks,
+		s.AvgAckTime,
+	)
+}
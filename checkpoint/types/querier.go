@@ -1,5 +1,9 @@
 package types
 
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
 // query endpoints supported by the auth Querier
 const (
 	QueryParams           = "params"
@@ -8,6 +12,12 @@ const (
 	QueryCheckpointBuffer = "checkpoint-buffer"
 	QueryLastNoAck        = "last-no-ack"
 	QueryCheckpointList   = "checkpoint-list"
+
+	QueryCheckpointByBlock     = "checkpoint-by-block"
+	QueryLastCheckpointedBlock = "last-checkpointed-block"
+
+	QueryProposerStats    = "proposer-stats"
+	QueryAllProposerStats = "all-proposer-stats"
 )
 
 // QueryCheckpointParams defines the params for querying accounts.
@@ -19,3 +29,65 @@ type QueryCheckpointParams struct {
 func NewQueryCheckpointParams(headerIndex uint64) QueryCheckpointParams {
 	return QueryCheckpointParams{HeaderIndex: headerIndex}
 }
+
+// QueryBlockParams defines the params for querying by bor block number.
+type QueryBlockParams struct {
+	BlockNumber uint64
+}
+
+// NewQueryBlockParams creates a new instance of QueryBlockParams.
+func NewQueryBlockParams(blockNumber uint64) QueryBlockParams {
+	return QueryBlockParams{BlockNumber: blockNumber}
+}
+
+// QueryValidatorParams defines the params for querying by validator id.
+type QueryValidatorParams struct {
+	ValidatorID hmTypes.ValidatorID
+}
+
+// NewQueryValidatorParams creates a new instance of QueryValidatorParams.
+func NewQueryValidatorParams(validatorID hmTypes.ValidatorID) QueryValidatorParams {
+	return QueryValidatorParams{ValidatorID: validatorID}
+}
+
+// BlockCheckpoint checkpoint which contains bor block, with its header index
+type BlockCheckpoint struct {
+	HeaderIndex uint64 `json:"header_index"`
+	hmTypes.CheckpointBlockHeader
+}
+
+// LastCheckpointedBlock last bor block covered by checkpoints
+type LastCheckpointedBlock struct {
+	BlockNumber uint64 `json:"block_number"`
+	HeaderIndex uint64 `json:"header_index"`
+}
+
+// BlockProof proof of bor block inclusion in checkpoint, verified by RootChain contract
+type BlockProof struct {
+	HeaderIndex uint64               `json:"header_index"`
+	StartBlock  uint64               `json:"start_block"`
+	EndBlock    uint64               `json:"end_block"`
+	RootHash    hmTypes.HeimdallHash `json:"root_hash"`
+	BlockNumber uint64               `json:"block_number"`
+	Leaf        hmTypes.HexBytes     `json:"leaf"`
+	Proof       hmTypes.HexBytes     `json:"proof"`
+}
+
+// NewBlockProof rebuilds tree of checkpoint which contains block from bor headers, and returns proof
+// of block inclusion. Headers are fetched over RPC, so it is meant for clients, not for queriers.
+func NewBlockProof(checkpoint BlockCheckpoint, blockNumber uint64) (BlockProof, error) {
+	leaf, proof, err := GetBlockProof(checkpoint.StartBlock, checkpoint.EndBlock, blockNumber)
+	if err != nil {
+		return BlockProof{}, err
+	}
+
+	return BlockProof{
+		HeaderIndex: checkpoint.HeaderIndex,
+		StartBlock:  checkpoint.StartBlock,
+		EndBlock:    checkpoint.EndBlock,
+		RootHash:    checkpoint.RootHash,
+		BlockNumber: blockNumber,
+		Leaf:        hmTypes.BytesToHexBytes(leaf),
+		Proof:       hmTypes.BytesToHexBytes(proof),
+	}, nil
+}
@@ -5,5 +5,7 @@ const (
 	FlagTxHash          = "tx-hash"
 	FlagLogIndex        = "log-index"
 	FlagRecordID        = "id"
+	FlagContractAddress = "contract-address"
+	FlagData            = "data"
 	FlagBorChainId      = "bor-chain-id"
 )
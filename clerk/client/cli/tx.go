@@ -83,12 +83,20 @@ func CreateNewStateRecord(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("log index cannot be empty")
 			}
 
+			// contract address
+			contractAddress := types.HexToHeimdallAddress(viper.GetString(FlagContractAddress))
+			if contractAddress.Empty() {
+				return fmt.Errorf("contract address cannot be empty")
+			}
+
 			// create new state record
 			msg := clerkTypes.NewMsgEventRecord(
 				proposer,
 				types.HexToHeimdallHash(txHashStr),
 				logIndex,
 				recordID,
+				contractAddress,
+				types.HexToHexBytes(viper.GetString(FlagData)),
 				borChainID,
 			)
 
@@ -99,11 +107,14 @@ func CreateNewStateRecord(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
 	cmd.Flags().String(FlagRecordID, "", "--id=<record-id>")
 	cmd.Flags().String(FlagBorChainId, "", "--bor-chain-id=<bor-chain-id>")
+	cmd.Flags().String(FlagContractAddress, "", "--contract-address=<contract-address>")
+	cmd.Flags().String(FlagData, "", "--data=<data>")
 	cmd.MarkFlagRequired(FlagProposerAddress)
 	cmd.MarkFlagRequired(FlagRecordID)
 	cmd.MarkFlagRequired(FlagTxHash)
 	cmd.MarkFlagRequired(FlagLogIndex)
 	cmd.MarkFlagRequired(FlagBorChainId)
+	cmd.MarkFlagRequired(FlagContractAddress)
 
 	return cmd
 }
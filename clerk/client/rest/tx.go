@@ -24,10 +24,12 @@ func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
 type AddRecordReq struct {
 	BaseReq rest.BaseReq `json:"base_req"`
 
-	TxHash     types.HeimdallHash `json:"tx_hash"`
-	LogIndex   uint64             `json:"log_index"`
-	ID         uint64             `json:"id"`
-	BorChainID string             `json:"bor_chain_id"`
+	TxHash          types.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                `json:"log_index"`
+	ID              uint64                `json:"id"`
+	ContractAddress types.HeimdallAddress `json:"contract_address"`
+	Data            types.HexBytes        `json:"data"`
+	BorChainID      string                `json:"bor_chain_id"`
 }
 
 func newEventRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
@@ -49,6 +51,8 @@ func newEventRecordHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.TxHash,
 			req.LogIndex,
 			req.ID,
+			req.ContractAddress,
+			req.Data,
 			req.BorChainID,
 		)
 
@@ -31,17 +31,17 @@ func handleQueryRecord(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([
 		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
 	}
 
+	// empty result if record doesn't exist
+	if !keeper.HasEventRecord(ctx, params.RecordID) {
+		return nil, nil
+	}
+
 	// get state record by record id
 	record, err := keeper.GetEventRecord(ctx, params.RecordID)
 	if err != nil {
 		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not get state record", err.Error()))
 	}
 
-	// return error if record doesn't exist
-	if record == nil {
-		return nil, sdk.ErrInternal(fmt.Sprintf("record %v does not exist", params.RecordID))
-	}
-
 	// json record
 	bz, err := json.Marshal(record)
 	if err != nil {
@@ -0,0 +1,50 @@
+package clerk
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/helper"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var sideTxLogger = helper.Logger.With("module", "clerk/side")
+
+// NewSideTxHandler returns handler which validates clerk msgs against mainchain.
+// It is run by validators outside of consensus (see sidechannel).
+func NewSideTxHandler(contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(msg sdk.Msg) (bool, error) {
+		switch msg := msg.(type) {
+		case types.MsgEventRecord:
+			return SideHandleMsgEventRecord(msg, contractCaller)
+		default:
+			return false, nil
+		}
+	}
+}
+
+// SideHandleMsgEventRecord validates event record against state synced event
+func SideHandleMsgEventRecord(msg types.MsgEventRecord, contractCaller helper.IContractCaller) (bool, error) {
+	// get confirmed tx receipt, retried until confirmed
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeStateSyncedEvent(helper.GetStateSenderAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch state synced log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	if eventLog.Id.Uint64() != msg.ID ||
+		!bytes.Equal(eventLog.ContractAddress.Bytes(), msg.ContractAddress.Bytes()) ||
+		!bytes.Equal(eventLog.Data, msg.Data) {
+		sideTxLogger.Error("Event record msg doesn't match log", "msgId", msg.ID, "stateIdFromTx", eventLog.Id, "contract", eventLog.ContractAddress.Hex())
+		return false, nil
+	}
+
+	return true, nil
+}
@@ -8,14 +8,17 @@ import (
 
 // MsgEventRecord - state msg
 type MsgEventRecord struct {
-	From     types.HeimdallAddress `json:"from"`
-	TxHash   types.HeimdallHash    `json:"tx_hash"`
-	LogIndex uint64                `json:"log_index"`
-	ID       uint64                `json:"id"`
-	ChainID  string                `json:"bor_chain_id"`
+	From            types.HeimdallAddress `json:"from"`
+	TxHash          types.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                `json:"log_index"`
+	ID              uint64                `json:"id"`
+	ContractAddress types.HeimdallAddress `json:"contract_address"`
+	Data            types.HexBytes        `json:"data"`
+	ChainID         string                `json:"bor_chain_id"`
 }
 
 var _ sdk.Msg = MsgEventRecord{}
+var _ types.SideTxMsg = MsgEventRecord{}
 
 // NewMsgEventRecord - construct state msg
 func NewMsgEventRecord(
@@ -23,14 +26,18 @@ func NewMsgEventRecord(
 	txHash types.HeimdallHash,
 	logIndex uint64,
 	id uint64,
+	contractAddress types.HeimdallAddress,
+	data types.HexBytes,
 	chainID string,
 ) MsgEventRecord {
 	return MsgEventRecord{
-		From:     from,
-		TxHash:   txHash,
-		LogIndex: logIndex,
-		ID:       id,
-		ChainID:  chainID,
+		From:            from,
+		TxHash:          txHash,
+		LogIndex:        logIndex,
+		ID:              id,
+		ContractAddress: contractAddress,
+		Data:            data,
+		ChainID:         chainID,
 	}
 }
 
@@ -71,3 +78,9 @@ func (msg MsgEventRecord) GetTxHash() types.HeimdallHash {
 func (msg MsgEventRecord) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
+
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgEventRecord) GetSideSignBytes() []byte {
+	msg.From = types.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
@@ -6,7 +6,6 @@ import (
 	"errors"
 	"math/big"
 	"strings"
-	"time"
 
 	"github.com/maticnetwork/bor/accounts/abi"
 	"github.com/maticnetwork/bor/common"
@@ -31,12 +30,12 @@ type IContractCaller interface {
 	GetLastChildBlock() (uint64, error)
 	CurrentHeaderBlock() (uint64, error)
 	GetBalance(address common.Address) (*big.Int, error)
-	SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte)
+	SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte, txManager *TxManager) error
 	GetCheckpointSign(txHash common.Hash) ([]byte, []byte, []byte, error)
 	GetMainChainBlock(*big.Int) (*ethTypes.Header, error)
 	GetMaticChainBlock(*big.Int) (*ethTypes.Header, error)
-	IsTxConfirmed(time.Time, common.Hash) bool
-	GetConfirmedTxReceipt(time.Time, common.Hash) (*ethTypes.Receipt, error)
+	IsTxConfirmed(common.Hash) bool
+	GetConfirmedTxReceipt(common.Hash) (*ethTypes.Receipt, error)
 	GetBlockNumberFromTxHash(common.Hash) (*big.Int, error)
 
 	// decode header event
@@ -47,6 +46,10 @@ type IContractCaller interface {
 	DecodeValidatorStakeUpdateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoStakeUpdate, error)
 	DecodeValidatorExitEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoUnstakeInit, error)
 	DecodeSignerUpdateEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoSignerChange, error)
+	DecodeValidatorReStakeEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoReStaked, error)
+	DecodeValidatorJailedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoJailed, error)
+	DecodeShareMintedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareMinted, error)
+	DecodeShareBurnedEvent(common.Address, *ethTypes.Receipt, uint64) (*stakinginfo.StakinginfoShareBurned, error)
 	// decode state events
 	DecodeStateSyncedEvent(common.Address, *ethTypes.Receipt, uint64) (*statesender.StatesenderStateSynced, error)
 
@@ -289,10 +292,15 @@ func (c *ContractCaller) GetBlockNumberFromTxHash(tx common.Hash) (*big.Int, err
 	return blkNum, nil
 }
 
+// IsBlockConfirmed checks if block has `confirmation_blocks` blocks on top of it
+func IsBlockConfirmed(blockNumber uint64, latestBlockNumber uint64) bool {
+	return latestBlockNumber >= blockNumber+GetConfirmationBlocks()
+}
+
 // IsTxConfirmed is tx confirmed
-func (c *ContractCaller) IsTxConfirmed(currentTime time.Time, tx common.Hash) bool {
+func (c *ContractCaller) IsTxConfirmed(tx common.Hash) bool {
 	// get main tx receipt
-	receipt, err := c.GetConfirmedTxReceipt(currentTime, tx)
+	receipt, err := c.GetConfirmedTxReceipt(tx)
 	if receipt == nil || err != nil {
 		return false
 	}
@@ -300,8 +308,8 @@ func (c *ContractCaller) IsTxConfirmed(currentTime time.Time, tx common.Hash) bo
 	return true
 }
 
-// GetConfirmedTxReceipt returns confirmed tx receipt
-func (c *ContractCaller) GetConfirmedTxReceipt(currentTime time.Time, tx common.Hash) (*ethTypes.Receipt, error) {
+// GetConfirmedTxReceipt returns tx receipt once tx block has `confirmation_blocks` blocks on top of it
+func (c *ContractCaller) GetConfirmedTxReceipt(tx common.Hash) (*ethTypes.Receipt, error) {
 	// get main tx receipt
 	receipt, err := c.GetMainTxReceipt(tx)
 	if err != nil {
@@ -310,18 +318,16 @@ func (c *ContractCaller) GetConfirmedTxReceipt(currentTime time.Time, tx common.
 	}
 	Logger.Debug("Tx included in block", "block", receipt.BlockNumber.Uint64(), "tx", tx)
 
-	// get main chain block
-	receiptBlock, err := c.GetMainChainBlock(receipt.BlockNumber)
+	// get latest main chain block
+	latestBlock, err := c.GetMainChainBlock(nil)
 	if err != nil {
-		Logger.Error("error getting receipt block from main chain", "Error", err)
+		Logger.Error("error getting latest block from main chain", "Error", err)
 		return nil, err
 	}
-	Logger.Debug("Receipt block on main chain obtained", "Block", receiptBlock.Number.Uint64())
-
-	// check if current time is greater than buffer time
-	bufferTime := receiptBlock.Time + uint64(GetConfig().TxConfirmationTime.Seconds())
+	Logger.Debug("Latest block on main chain obtained", "Block", latestBlock.Number.Uint64())
 
-	if uint64(currentTime.Unix()) < bufferTime {
+	// check if receipt block is deep enough
+	if !IsBlockConfirmed(receipt.BlockNumber.Uint64(), latestBlock.Number.Uint64()) {
 		return nil, errors.New("Not enough confirmations")
 	}
 
@@ -442,6 +448,94 @@ func (c *ContractCaller) DecodeValidatorExitEvent(contractAddress common.Address
 	return event, nil
 }
 
+// DecodeValidatorReStakeEvent represents validator restake event
+func (c *ContractCaller) DecodeValidatorReStakeEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoReStaked, error) {
+	event := new(stakinginfo.StakinginfoReStaked)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ReStaked", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeValidatorJailedEvent represents validator jailed event
+func (c *ContractCaller) DecodeValidatorJailedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoJailed, error) {
+	event := new(stakinginfo.StakinginfoJailed)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "Jailed", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeShareMintedEvent represents delegator share minted event
+func (c *ContractCaller) DecodeShareMintedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	event := new(stakinginfo.StakinginfoShareMinted)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareMinted", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
+// DecodeShareBurnedEvent represents delegator share burned event
+func (c *ContractCaller) DecodeShareBurnedEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	event := new(stakinginfo.StakinginfoShareBurned)
+
+	found := false
+	for _, vLog := range receipt.Logs {
+		if uint64(vLog.Index) == logIndex && bytes.Equal(vLog.Address.Bytes(), contractAddress.Bytes()) {
+			found = true
+			if err := UnpackLog(&c.StakingInfoABI, event, "ShareBurned", vLog); err != nil {
+				return nil, err
+			}
+			break
+		}
+	}
+
+	if !found {
+		return nil, errors.New("Event not found")
+	}
+
+	return event, nil
+}
+
 // DecodeSignerUpdateEvent represents sig update event
 func (c *ContractCaller) DecodeSignerUpdateEvent(contractAddress common.Address, receipt *ethTypes.Receipt, logIndex uint64) (*stakinginfo.StakinginfoSignerChange, error) {
 	event := new(stakinginfo.StakinginfoSignerChange)
@@ -6,6 +6,7 @@ import (
 	big "math/big"
 
 	common "github.com/maticnetwork/bor/common"
+	helper "github.com/maticnetwork/heimdall/helper"
 	heimdalltypes "github.com/maticnetwork/heimdall/types"
 
 	mock "github.com/stretchr/testify/mock"
@@ -14,6 +15,8 @@ import (
 
 	stakemanager "github.com/maticnetwork/heimdall/contracts/stakemanager"
 
+	stakinginfo "github.com/maticnetwork/heimdall/contracts/stakinginfo"
+
 	statesender "github.com/maticnetwork/heimdall/contracts/statesender"
 
 	types "github.com/maticnetwork/bor/core/types"
@@ -169,6 +172,98 @@ func (_m *IContractCaller) DecodeValidatorStakeUpdateEvent(_a0 *types.Receipt, _
 	return r0, r1
 }
 
+// DecodeValidatorReStakeEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeValidatorReStakeEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoReStaked, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoReStaked
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoReStaked); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoReStaked)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeValidatorJailedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeValidatorJailedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoJailed, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoJailed
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoJailed); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoJailed)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeShareMintedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeShareMintedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoShareMinted, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoShareMinted
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoShareMinted); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareMinted)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DecodeShareBurnedEvent provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IContractCaller) DecodeShareBurnedEvent(_a0 common.Address, _a1 *types.Receipt, _a2 uint64) (*stakinginfo.StakinginfoShareBurned, error) {
+	ret := _m.Called(_a0, _a1, _a2)
+
+	var r0 *stakinginfo.StakinginfoShareBurned
+	if rf, ok := ret.Get(0).(func(common.Address, *types.Receipt, uint64) *stakinginfo.StakinginfoShareBurned); ok {
+		r0 = rf(_a0, _a1, _a2)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*stakinginfo.StakinginfoShareBurned)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(common.Address, *types.Receipt, uint64) error); ok {
+		r1 = rf(_a0, _a1, _a2)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DecodeValidatorTopupFeesEvent provides a mock function with given fields: _a0, _a1
 func (_m *IContractCaller) DecodeValidatorTopupFeesEvent(_a0 *types.Receipt, _a1 uint64) (*stakemanager.StakemanagerTopUpFee, error) {
 	ret := _m.Called(_a0, _a1)
@@ -567,7 +662,16 @@ func (_m *IContractCaller) IsTxConfirmed(_a0 common.Hash) bool {
 	return r0
 }
 
-// SendCheckpoint provides a mock function with given fields: voteSignBytes, sigs, txData
-func (_m *IContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte) {
-	_m.Called(voteSignBytes, sigs, txData)
+// SendCheckpoint provides a mock function with given fields: voteSignBytes, sigs, txData, txManager
+func (_m *IContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte, txManager *helper.TxManager) error {
+	ret := _m.Called(voteSignBytes, sigs, txData, txManager)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]byte, []byte, []byte, *helper.TxManager) error); ok {
+		r0 = rf(voteSignBytes, sigs, txData, txManager)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
 }
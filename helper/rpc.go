@@ -0,0 +1,281 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/maticnetwork/bor/common/hexutil"
+	"github.com/maticnetwork/bor/rpc"
+)
+
+// timeout of single endpoint health check
+const rpcHealthCheckTimeout = 5 * time.Second
+
+// blockNumberRequest json-rpc request used for endpoint health checks
+var blockNumberRequest = []byte(`{"jsonrpc":"2.0","id":1,"method":"eth_blockNumber","params":[]}`)
+
+// rpcEndpoint state of single rpc endpoint
+type rpcEndpoint struct {
+	url     *url.URL
+	healthy bool
+	head    uint64
+}
+
+// failoverTransport routes json-rpc requests of rpc client to first healthy endpoint (in config order),
+// failing over to next endpoint on network or server errors.
+//
+// Endpoints are health checked with `eth_blockNumber` on creation, and then in background (started
+// lazily by requests, at most once per check interval, so requests never wait for it). Endpoint which
+// fails check or whose head lags behind the best head by more than max lag is skipped until next check.
+type failoverTransport struct {
+	transport http.RoundTripper
+
+	endpoints     []*rpcEndpoint
+	maxLag        uint64
+	checkInterval time.Duration
+	lastCheck     time.Time
+	checking      bool
+
+	mu sync.Mutex
+}
+
+// newFailoverTransport creates failover transport for given endpoint urls
+func newFailoverTransport(urls []string, transport http.RoundTripper, maxLag uint64, checkInterval time.Duration) (*failoverTransport, error) {
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	t := &failoverTransport{
+		transport:     transport,
+		maxLag:        maxLag,
+		checkInterval: checkInterval,
+	}
+
+	for _, rawURL := range urls {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, err
+		}
+		if u.Scheme != "http" && u.Scheme != "https" {
+			return nil, fmt.Errorf("Only http(s) endpoints are supported for failover, got: %v", rawURL)
+		}
+		t.endpoints = append(t.endpoints, &rpcEndpoint{url: u, healthy: true})
+	}
+
+	t.lastCheck = time.Now()
+	t.check()
+
+	return t, nil
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *failoverTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	t.checkIfDue()
+
+	// request body is replayed to each endpoint
+	var body []byte
+	if req.Body != nil {
+		var err error
+		if body, err = ioutil.ReadAll(req.Body); err != nil {
+			return nil, err
+		}
+		req.Body.Close()
+	}
+
+	var resp *http.Response
+	var err error
+	for _, endpoint := range t.candidates() {
+		resp, err = t.transport.RoundTrip(endpointRequest(req, endpoint.url, body))
+		if err == nil && resp.StatusCode < http.StatusInternalServerError {
+			return resp, nil
+		}
+
+		// canceled request says nothing about endpoint, don't fail over
+		if req.Context().Err() != nil || err == context.Canceled {
+			break
+		}
+
+		if err == nil {
+			resp.Body.Close()
+			err = fmt.Errorf("rpc endpoint %v returned status %v", endpoint.url.Host, resp.StatusCode)
+		}
+
+		Logger.Error("RPC endpoint failed, failing over", "endpoint", endpoint.url.Host, "error", err)
+		t.markUnhealthy(endpoint)
+	}
+
+	return nil, err
+}
+
+// candidates returns healthy endpoints followed by unhealthy ones (which are tried as last resort)
+func (t *failoverTransport) candidates() []*rpcEndpoint {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	result := make([]*rpcEndpoint, 0, len(t.endpoints))
+	for _, endpoint := range t.endpoints {
+		if endpoint.healthy {
+			result = append(result, endpoint)
+		}
+	}
+	for _, endpoint := range t.endpoints {
+		if !endpoint.healthy {
+			result = append(result, endpoint)
+		}
+	}
+	return result
+}
+
+func (t *failoverTransport) markUnhealthy(endpoint *rpcEndpoint) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	endpoint.healthy = false
+}
+
+// checkIfDue starts health check of endpoints in background if check interval has passed since last check
+func (t *failoverTransport) checkIfDue() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.checking || time.Since(t.lastCheck) < t.checkInterval {
+		return
+	}
+	t.checking = true
+	t.lastCheck = time.Now()
+	go t.check()
+}
+
+// check fetches head of all endpoints, and marks failed and lagging endpoints unhealthy
+func (t *failoverTransport) check() {
+	heads := make([]uint64, len(t.endpoints))
+	errs := make([]error, len(t.endpoints))
+
+	var wg sync.WaitGroup
+	for i, endpoint := range t.endpoints {
+		wg.Add(1)
+		go func(i int, endpoint *rpcEndpoint) {
+			defer wg.Done()
+			heads[i], errs[i] = t.fetchHead(endpoint.url)
+		}(i, endpoint)
+	}
+	wg.Wait()
+
+	var best uint64
+	for i := range t.endpoints {
+		if errs[i] == nil && heads[i] > best {
+			best = heads[i]
+		}
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.checking = false
+	for i, endpoint := range t.endpoints {
+		// canceled check says nothing about endpoint, keep its state
+		if errs[i] == context.Canceled {
+			continue
+		}
+
+		endpoint.head = heads[i]
+		endpoint.healthy = errs[i] == nil && best-heads[i] <= t.maxLag
+
+		if errs[i] != nil {
+			Logger.Error("RPC endpoint health check failed", "endpoint", endpoint.url.Host, "error", errs[i])
+		} else if !endpoint.healthy {
+			Logger.Error("RPC endpoint is lagging behind", "endpoint", endpoint.url.Host, "head", heads[i], "bestHead", best)
+		}
+	}
+}
+
+// fetchHead fetches latest block number of endpoint
+func (t *failoverTransport) fetchHead(endpointURL *url.URL) (uint64, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), rpcHealthCheckTimeout)
+	defer cancel()
+
+	req, err := http.NewRequest(http.MethodPost, endpointURL.String(), bytes.NewReader(blockNumberRequest))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := t.transport.RoundTrip(req.WithContext(ctx))
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("status %v", resp.StatusCode)
+	}
+
+	var result struct {
+		Result hexutil.Uint64 `json:"result"`
+		Error  *struct {
+			Message string `json:"message"`
+		} `json:"error"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&result); err != nil {
+		return 0, err
+	}
+	if result.Error != nil {
+		return 0, fmt.Errorf("rpc error: %v", result.Error.Message)
+	}
+
+	return uint64(result.Result), nil
+}
+
+// endpointRequest copies request for given endpoint url
+func endpointRequest(req *http.Request, endpointURL *url.URL, body []byte) *http.Request {
+	r := req.WithContext(req.Context())
+	u := *endpointURL
+	r.URL = &u
+	r.Host = u.Host
+	if body != nil {
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		r.ContentLength = int64(len(body))
+	}
+	return r
+}
+
+// splitRPCUrls splits comma separated rpc endpoints
+func splitRPCUrls(urls string) []string {
+	var result []string
+	for _, u := range strings.Split(urls, ",") {
+		if u = strings.TrimSpace(u); u != "" {
+			result = append(result, u)
+		}
+	}
+	return result
+}
+
+// dialRPC dials rpc endpoint(s) from comma separated urls. Multiple (http) endpoints are wrapped
+// in failover transport; single endpoint uses rpc transport for http endpoints.
+func dialRPC(urls string) (*rpc.Client, error) {
+	endpoints := splitRPCUrls(urls)
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("No rpc endpoint configured")
+	}
+
+	if len(endpoints) > 1 {
+		transport, err := newFailoverTransport(endpoints, rpcTransport, GetRPCMaxBlockLag(), GetRPCHealthCheckInterval())
+		if err != nil {
+			return nil, err
+		}
+		return rpc.DialHTTPWithClient(endpoints[0], &http.Client{Transport: transport})
+	}
+
+	endpoint := endpoints[0]
+	if rpcTransport != nil && (strings.HasPrefix(endpoint, "http://") || strings.HasPrefix(endpoint, "https://")) {
+		return rpc.DialHTTPWithClient(endpoint, &http.Client{Transport: rpcTransport})
+	}
+
+	return rpc.Dial(endpoint)
+}
@@ -0,0 +1,120 @@
+package helper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// testRPCServer rpc server which returns given head, and counts other requests
+func testRPCServer(head uint64, status *int32, calls *int32) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if bytes.Equal(body, blockNumberRequest) {
+			fmt.Fprintf(w, `{"jsonrpc":"2.0","id":1,"result":"0x%x"}`, head)
+			return
+		}
+
+		atomic.AddInt32(calls, 1)
+		if code := atomic.LoadInt32(status); code != http.StatusOK {
+			w.WriteHeader(int(code))
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":2,"result":"0x1"}`))
+	}))
+}
+
+func TestFailoverTransport(t *testing.T) {
+	var laggingCalls, primaryCalls, secondaryCalls int32
+	laggingStatus, primaryStatus, secondaryStatus := int32(http.StatusOK), int32(http.StatusOK), int32(http.StatusOK)
+
+	lagging := testRPCServer(80, &laggingStatus, &laggingCalls)
+	defer lagging.Close()
+	primary := testRPCServer(100, &primaryStatus, &primaryCalls)
+	defer primary.Close()
+	secondary := testRPCServer(95, &secondaryStatus, &secondaryCalls)
+	defer secondary.Close()
+
+	transport, err := newFailoverTransport([]string{lagging.URL, primary.URL, secondary.URL}, nil, 10, time.Hour)
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	call := func() error {
+		resp, err := client.Post(lagging.URL, "application/json", bytes.NewReader([]byte(`{"jsonrpc":"2.0","id":2,"method":"eth_chainId","params":[]}`)))
+		if err != nil {
+			return err
+		}
+		resp.Body.Close()
+		return nil
+	}
+
+	// lagging endpoint is skipped
+	require.NoError(t, call())
+	require.EqualValues(t, 0, atomic.LoadInt32(&laggingCalls))
+	require.EqualValues(t, 1, atomic.LoadInt32(&primaryCalls))
+
+	// failing endpoint is failed over
+	atomic.StoreInt32(&primaryStatus, http.StatusBadGateway)
+	require.NoError(t, call())
+	require.EqualValues(t, 2, atomic.LoadInt32(&primaryCalls))
+	require.EqualValues(t, 1, atomic.LoadInt32(&secondaryCalls))
+
+	// and not tried again until next health check
+	require.NoError(t, call())
+	require.EqualValues(t, 2, atomic.LoadInt32(&primaryCalls))
+	require.EqualValues(t, 2, atomic.LoadInt32(&secondaryCalls))
+
+	// websocket endpoints can't be failed over
+	_, err = newFailoverTransport([]string{primary.URL, "ws://localhost:8546"}, nil, 10, time.Hour)
+	require.Error(t, err)
+}
+
+func TestFailoverTransportHealthCheckInBackground(t *testing.T) {
+	var calls int32
+	status := int32(http.StatusOK)
+	var blockCheck int32
+	release := make(chan struct{})
+
+	server := testRPCServer(100, &status, &calls)
+	defer server.Close()
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.LoadInt32(&blockCheck) == 1 {
+			<-release
+		}
+		server.Config.Handler.ServeHTTP(w, r)
+	}))
+	defer slow.Close()
+
+	transport, err := newFailoverTransport([]string{server.URL, slow.URL}, nil, 10, time.Millisecond)
+	require.NoError(t, err)
+	client := &http.Client{Transport: transport}
+
+	// request doesn't wait for slow health check
+	atomic.StoreInt32(&blockCheck, 1)
+	time.Sleep(5 * time.Millisecond)
+	resp, err := client.Post(server.URL, "application/json", bytes.NewReader([]byte(`{"jsonrpc":"2.0","id":2,"method":"eth_chainId","params":[]}`)))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.EqualValues(t, 1, atomic.LoadInt32(&calls))
+	close(release)
+
+	// canceled request doesn't mark endpoint unhealthy
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequest(http.MethodPost, server.URL, bytes.NewReader([]byte(`{"jsonrpc":"2.0","id":2,"method":"eth_chainId","params":[]}`)))
+	require.NoError(t, err)
+	_, err = client.Do(req.WithContext(ctx))
+	require.Error(t, err)
+
+	transport.mu.Lock()
+	defer transport.mu.Unlock()
+	require.True(t, transport.endpoints[0].healthy)
+}
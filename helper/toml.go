@@ -15,12 +15,16 @@ const defaultConfigTemplate = `# This is a TOML config file.
 
 ##### RPC configrations #####
 
-# RPC endpoint for ethereum chain
+# RPC endpoint for ethereum chain (comma separated http endpoints are used for failover)
 eth_RPC_URL = "{{ .EthRPCUrl }}"
 
-# RPC endpoint for bor chain
+# RPC endpoint for bor chain (comma separated http endpoints are used for failover)
 bor_RPC_URL = "{{ .BorRPCUrl }}"
 
+# Endpoint whose head lags behind best endpoint by more than this many blocks is skipped
+rpc_max_block_lag = "{{ .RPCMaxBlockLag }}"
+rpc_health_check_interval = "{{ .RPCHealthCheckInterval }}"
+
 
 # RPC endpoint for tendermint
 tendermint_RPC_URL = "{{ .TendermintRPCUrl }}"
@@ -36,9 +40,12 @@ bor_chain_id = "{{ .BorChainID }}"
 # MQTT endpoint
 amqp_url = "{{ .AmqpURL }}" 
 
-# Heimdall REST server endpoint
+# Heimdall REST server endpoint (comma separated endpoints are used for failover)
 heimdall_rest_server = "{{ .HeimdallServerURL }}" 
 
+# Bridge queue backend: "amqp" (uses amqp_url) or "leveldb" (embedded, stored in bridge db)
+queue_backend = "{{ .QueueBackend }}"
+
 ##### Contract Addresses #####
 
 ### Eth Chain Contracts 
@@ -53,28 +60,27 @@ state_receiver_contract = "{{ .StateReceiverAddress }}"
 validator_set_contract = "{{ .ValidatorSetAddress }}" 
 
 
-##### Intervals #####
-child_chain_block_interval = "{{ .ChildBlockInterval }}" 
-
-## Bridge Poll Intervals 
+##### Bridge Poll Intervals #####
 checkpoint_poll_interval = "{{ .CheckpointerPollInterval }}" 
 syncer_poll_interval = "{{ .SyncerPollInterval }}"
 noack_poll_interval = "{{ .NoACKPollInterval }}"
 clerk_polling_interval = "{{ .ClerkPollingInterval }}" 
 span_polling_interval = "{{ .SpanPollingInterval }}" 
+sidetx_polling_interval = "{{ .SideTxPollingInterval }}"
 
 
-##### Checkpoint Length Config #####
-avg_checkpoint_length = "{{ .AvgCheckpointLength }}"
-max_checkpoint_length = "{{ .MaxCheckpointLength }}"
-
 ##### Timeout Config #####
 
 no_ack_wait_time = "{{ .NoACKWaitTime }}"
 
 ##### Transaction Confirmations  #####
 
-tx_confirmation_time = "{{ .TxConfirmationTime }}"
+confirmation_blocks = "{{ .ConfirmationBlocks }}"
+
+##### Transaction Manager #####
+
+tx_resubmit_timeout = "{{ .TxResubmitTimeout }}"
+gas_price_bump_percent = "{{ .GasPriceBumpPercent }}"
 
 `
 
@@ -56,42 +56,34 @@ func GenerateAuthObj(client *ethclient.Client, address common.Address, data []by
 	return
 }
 
-// SendCheckpoint sends checkpoint to rootchain contract
-// todo return err
-func (c *ContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte) {
+// SendCheckpoint sends checkpoint to rootchain contract using tx manager
+func (c *ContractCaller) SendCheckpoint(voteSignBytes []byte, sigs []byte, txData []byte, txManager *TxManager) error {
 	var vote types.CanonicalRLPVote
 	err := rlp.DecodeBytes(voteSignBytes, &vote)
 	if err != nil {
 		Logger.Error("Unable to decode vote while sending checkpoint", "vote", hex.EncodeToString(voteSignBytes), "sigs", hex.EncodeToString(sigs), "txData", hex.EncodeToString(txData))
-		return
+		return err
 	}
 
 	data, err := c.RootChainABI.Pack("submitHeaderBlock", voteSignBytes, sigs, txData)
 	if err != nil {
 		Logger.Error("Unable to pack tx for submitHeaderBlock", "error", err)
-		return
-	}
-
-	rootChainAddress := GetRootChainAddress()
-	auth, err := GenerateAuthObj(GetMainClient(), rootChainAddress, data)
-	if err != nil {
-		Logger.Error("Unable to create auth object", "error", err)
-		return
+		return err
 	}
-	GetPubKey().VerifyBytes(voteSignBytes, sigs)
 
 	Logger.Debug("Sending new checkpoint",
 		"vote", hex.EncodeToString(voteSignBytes),
 		"sigs", hex.EncodeToString(sigs),
 		"txData", hex.EncodeToString(txData))
 
-	tx, err := c.RootChainInstance.SubmitHeaderBlock(auth, voteSignBytes, sigs, txData)
+	tx, err := txManager.SendTransaction(GetRootChainAddress(), nil, data)
 	if err != nil {
 		Logger.Error("Error while submitting checkpoint", "error", err)
-	} else {
-		Logger.Info("Submitted new header successfully", "txHash", tx.Hash().String())
+		return err
 	}
 
+	Logger.Info("Submitted new header successfully", "txHash", tx.Hash().String())
+	return nil
 }
 
 // StakeFor stakes for a validator
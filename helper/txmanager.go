@@ -0,0 +1,385 @@
+package helper
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+	"time"
+
+	ethereum "github.com/maticnetwork/bor"
+	"github.com/maticnetwork/bor/common"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+	"github.com/maticnetwork/bor/crypto"
+	"github.com/maticnetwork/bor/ethclient"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
+	"github.com/tendermint/tendermint/libs/log"
+)
+
+const (
+	// storage key prefix for pending txs, followed by tx manager name and nonce
+	pendingTxPrefix = "pending-tx-"
+
+	// interval to check pending txs
+	txManagerPollInterval = 15 * time.Second
+)
+
+// PendingTx transaction sent by tx manager which is not mined yet
+type PendingTx struct {
+	Nonce    uint64         `json:"nonce"`
+	To       common.Address `json:"to"`
+	Value    *big.Int       `json:"value"`
+	Data     []byte         `json:"data"`
+	GasLimit uint64         `json:"gas_limit"`
+	GasPrice *big.Int       `json:"gas_price"`
+
+	// hashes of all submitted versions (original and replacements)
+	Hashes      []common.Hash `json:"hashes"`
+	SubmittedAt time.Time     `json:"submitted_at"`
+}
+
+// Hash returns hash of latest submitted version
+func (tx *PendingTx) Hash() common.Hash {
+	return tx.Hashes[len(tx.Hashes)-1]
+}
+
+// ReceiptHandler is called with final receipt of tx sent by tx manager
+type ReceiptHandler func(tx *PendingTx, receipt *ethTypes.Receipt)
+
+// TxManager sends transactions on a chain from validator account and tracks them until they are mined.
+// Pending txs are persisted, so they are tracked across restarts, and stuck txs are
+// resubmitted with bumped gas price after `tx_resubmit_timeout`.
+type TxManager struct {
+	name    string
+	client  *ethclient.Client
+	signer  ethTypes.Signer
+	from    common.Address
+	privKey *ecdsa.PrivateKey
+
+	// storage client
+	storageClient *leveldb.DB
+
+	resubmitTimeout time.Duration
+	gasPriceBump    uint64
+
+	// next nonce to use (0 if it needs to be fetched from chain)
+	nextNonce uint64
+
+	// signed txs are only logged, not sent
+	dryRun bool
+
+	receiptHandler ReceiptHandler
+
+	mutex  sync.Mutex
+	quit   chan struct{}
+	logger log.Logger
+}
+
+// NewTxManager creates tx manager with given name (used for storage keys) for chain client
+func NewTxManager(name string, client *ethclient.Client, storageClient *leveldb.DB) (*TxManager, error) {
+	if storageClient == nil {
+		return nil, errors.New("Storage is required for tx manager")
+	}
+
+	// create ecdsa private key
+	pkObject := GetPrivKey()
+	privKey, err := crypto.ToECDSA(pkObject[:])
+	if err != nil {
+		return nil, err
+	}
+
+	resubmitTimeout := GetConfig().TxResubmitTimeout
+	if resubmitTimeout == 0 {
+		resubmitTimeout = DefaultTxResubmitTimeout
+	}
+
+	gasPriceBump := GetConfig().GasPriceBumpPercent
+	if gasPriceBump == 0 {
+		gasPriceBump = DefaultGasPriceBumpPercent
+	}
+
+	logger := Logger.With("module", "tx-manager", "chain", name)
+	return &TxManager{
+		name:            name,
+		client:          client,
+		signer:          ethTypes.HomesteadSigner{},
+		from:            common.BytesToAddress(pkObject.PubKey().Address().Bytes()),
+		privKey:         privKey,
+		storageClient:   storageClient,
+		resubmitTimeout: resubmitTimeout,
+		gasPriceBump:    gasPriceBump,
+		receiptHandler: func(tx *PendingTx, receipt *ethTypes.Receipt) {
+			logger.Info("Transaction mined", "txHash", receipt.TxHash.Hex(), "nonce", tx.Nonce, "status", receipt.Status, "block", receipt.BlockNumber)
+		},
+		logger: logger,
+	}, nil
+}
+
+// SetReceiptHandler sets handler called with final receipt of each tx
+func (m *TxManager) SetReceiptHandler(handler ReceiptHandler) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.receiptHandler = handler
+}
+
+// SetDryRun enables dry-run mode, in which txs are built and signed but only logged
+func (m *TxManager) SetDryRun(dryRun bool) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	m.dryRun = dryRun
+}
+
+// Start starts tracking pending txs
+func (m *TxManager) Start() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.quit != nil {
+		return
+	}
+	m.quit = make(chan struct{})
+
+	go func(quit chan struct{}) {
+		ticker := time.NewTicker(txManagerPollInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				m.checkPendingTxs()
+			case <-quit:
+				return
+			}
+		}
+	}(m.quit)
+}
+
+// Stop stops tracking pending txs (they are tracked again after next start)
+func (m *TxManager) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.quit != nil {
+		close(m.quit)
+		m.quit = nil
+	}
+}
+
+// SendTransaction signs and sends tx with next nonce, and tracks it until it is mined
+func (m *TxManager) SendTransaction(to common.Address, value *big.Int, data []byte) (*PendingTx, error) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if value == nil {
+		value = big.NewInt(0)
+	}
+
+	// fetch nonce, tracked nonce is used if node doesn't know about our pending txs yet
+	nonce, err := m.client.PendingNonceAt(context.Background(), m.from)
+	if err != nil {
+		return nil, err
+	}
+	if m.nextNonce > nonce {
+		nonce = m.nextNonce
+	}
+
+	// fetch gas price
+	gasPrice, err := m.client.SuggestGasPrice(context.Background())
+	if err != nil {
+		return nil, err
+	}
+
+	// fetch gas limit
+	gasLimit, err := m.client.EstimateGas(context.Background(), ethereum.CallMsg{
+		From:  m.from,
+		To:    &to,
+		Value: value,
+		Data:  data,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &PendingTx{
+		Nonce:    nonce,
+		To:       to,
+		Value:    value,
+		Data:     data,
+		GasLimit: gasLimit,
+		GasPrice: gasPrice,
+	}
+
+	if m.dryRun {
+		signedTx, err := m.sign(tx)
+		if err != nil {
+			return nil, err
+		}
+
+		tx.Hashes = append(tx.Hashes, signedTx.Hash())
+		m.logger.Info("Dry run, transaction not sent", "txHash", tx.Hash().Hex(), "to", tx.To.Hex(), "value", tx.Value, "data", hex.EncodeToString(tx.Data), "nonce", tx.Nonce, "gasLimit", tx.GasLimit, "gasPrice", tx.GasPrice)
+		return tx, nil
+	}
+
+	if err := m.submit(tx); err != nil {
+		// nonce might be wrong, fetch it again for next tx
+		m.nextNonce = 0
+		return nil, err
+	}
+
+	m.nextNonce = nonce + 1
+	m.logger.Info("Transaction sent", "txHash", tx.Hash().Hex(), "nonce", tx.Nonce, "gasPrice", tx.GasPrice)
+	return tx, nil
+}
+
+// PendingTxs returns all pending txs ordered by nonce
+func (m *TxManager) PendingTxs() ([]*PendingTx, error) {
+	iterator := m.storageClient.NewIterator(util.BytesPrefix(m.keyPrefix()), nil)
+	defer iterator.Release()
+
+	var txs []*PendingTx
+	for iterator.Next() {
+		var tx PendingTx
+		if err := json.Unmarshal(iterator.Value(), &tx); err != nil {
+			return nil, err
+		}
+		txs = append(txs, &tx)
+	}
+
+	sort.Slice(txs, func(i, j int) bool {
+		return txs[i].Nonce < txs[j].Nonce
+	})
+	return txs, iterator.Error()
+}
+
+// checkPendingTxs reports mined txs, resubmits stuck txs and detects nonce gaps
+func (m *TxManager) checkPendingTxs() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	txs, err := m.PendingTxs()
+	if err != nil {
+		m.logger.Error("Error while fetching pending transactions", "error", err)
+		return
+	}
+
+	if len(txs) == 0 {
+		return
+	}
+
+	// nonce of last mined tx + 1
+	confirmedNonce, err := m.client.NonceAt(context.Background(), m.from, nil)
+	if err != nil {
+		m.logger.Error("Error while fetching account nonce", "error", err)
+		return
+	}
+
+	// txs between confirmed nonce and first pending tx are not tracked
+	if txs[0].Nonce > confirmedNonce {
+		m.logger.Error("Nonce gap detected, transactions not tracked by tx manager are pending", "fromNonce", confirmedNonce, "toNonce", txs[0].Nonce-1)
+	}
+
+	for _, tx := range txs {
+		if receipt := m.findReceipt(tx); receipt != nil {
+			m.receiptHandler(tx, receipt)
+			m.remove(tx)
+			continue
+		}
+
+		if tx.Nonce < confirmedNonce {
+			// nonce is used by some other tx
+			m.logger.Error("Transaction nonce used by another transaction, dropping it", "txHash", tx.Hash().Hex(), "nonce", tx.Nonce)
+			m.remove(tx)
+			continue
+		}
+
+		if time.Since(tx.SubmittedAt) >= m.resubmitTimeout {
+			m.resubmit(tx)
+		}
+	}
+}
+
+// findReceipt returns receipt of any submitted version of tx
+func (m *TxManager) findReceipt(tx *PendingTx) *ethTypes.Receipt {
+	for _, hash := range tx.Hashes {
+		receipt, err := m.client.TransactionReceipt(context.Background(), hash)
+		if err == nil && receipt != nil {
+			return receipt
+		}
+	}
+	return nil
+}
+
+// resubmit replaces tx with same tx with bumped gas price
+func (m *TxManager) resubmit(tx *PendingTx) {
+	gasPrice := new(big.Int).Mul(tx.GasPrice, big.NewInt(int64(100+m.gasPriceBump)))
+	gasPrice = gasPrice.Div(gasPrice, big.NewInt(100))
+
+	// use suggested gas price if it is higher
+	if suggested, err := m.client.SuggestGasPrice(context.Background()); err == nil && suggested.Cmp(gasPrice) > 0 {
+		gasPrice = suggested
+	}
+
+	oldHash := tx.Hash()
+	tx.GasPrice = gasPrice
+	if err := m.submit(tx); err != nil {
+		m.logger.Error("Error while resubmitting transaction", "txHash", oldHash.Hex(), "nonce", tx.Nonce, "error", err)
+		return
+	}
+
+	m.logger.Info("Resubmitted stuck transaction", "oldTxHash", oldHash.Hex(), "txHash", tx.Hash().Hex(), "nonce", tx.Nonce, "gasPrice", tx.GasPrice)
+}
+
+// submit signs and sends tx with current gas price, and stores it as pending tx
+func (m *TxManager) submit(tx *PendingTx) error {
+	signedTx, err := m.sign(tx)
+	if err != nil {
+		return err
+	}
+
+	if err := m.client.SendTransaction(context.Background(), signedTx); err != nil {
+		return err
+	}
+
+	tx.Hashes = append(tx.Hashes, signedTx.Hash())
+	tx.SubmittedAt = time.Now().UTC()
+
+	data, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+
+	// tx is already sent, it would be tracked again once it is stored
+	if err := m.storageClient.Put(m.key(tx.Nonce), data, nil); err != nil {
+		m.logger.Error("Error while storing pending transaction", "txHash", signedTx.Hash().Hex(), "error", err)
+	}
+
+	return nil
+}
+
+// sign signs tx with current gas price
+func (m *TxManager) sign(tx *PendingTx) (*ethTypes.Transaction, error) {
+	rawTx := ethTypes.NewTransaction(tx.Nonce, tx.To, tx.Value, tx.GasLimit, tx.GasPrice, tx.Data)
+	return ethTypes.SignTx(rawTx, m.signer, m.privKey)
+}
+
+// remove deletes pending tx from storage
+func (m *TxManager) remove(tx *PendingTx) {
+	if err := m.storageClient.Delete(m.key(tx.Nonce), nil); err != nil {
+		m.logger.Error("Error while removing pending transaction", "txHash", tx.Hash().Hex(), "error", err)
+	}
+}
+
+func (m *TxManager) keyPrefix() []byte {
+	return []byte(fmt.Sprintf("%s%s-", pendingTxPrefix, m.name))
+}
+
+// key returns storage key for pending tx, zero padded nonce keeps keys ordered
+func (m *TxManager) key(nonce uint64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", m.keyPrefix(), nonce))
+}
@@ -0,0 +1,229 @@
+package sidechannel
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// NewHandler creates new handler for handling messages for sidechannel module
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgSideTxVote:
+			return handleMsgSideTxVote(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in sidechannel module").Result()
+		}
+	}
+}
+
+// NewSideTxDecorator wraps module handler, adding side tx msgs to pending side txs instead of handling them.
+// Other msgs are passed to module handler.
+func NewSideTxDecorator(k Keeper, handler sdk.Handler) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		if sideTxMsg, ok := msg.(hmTypes.SideTxMsg); ok {
+			return handleSideTxMsg(ctx.WithEventManager(sdk.NewEventManager()), sideTxMsg, k)
+		}
+		return handler(ctx, msg)
+	}
+}
+
+// handleSideTxMsg adds side tx msg to pending side txs to await validator votes
+func handleSideTxMsg(ctx sdk.Context, msg hmTypes.SideTxMsg, k Keeper) sdk.Result {
+	// only validators relay mainchain events, others can't fill pending side txs
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	for _, signer := range msg.GetSigners() {
+		if _, validator := validatorSet.GetByAddress(signer.Bytes()); validator == nil {
+			k.Logger(ctx).Error("Side tx sender is not in current validator set", "sender", signer.String())
+			return types.ErrSideTxNotFromValidator(k.Codespace()).Result()
+		}
+	}
+
+	// msgs like checkpoint are only accepted from current proposer, so others can't bounce them
+	if proposerMsg, ok := msg.(hmTypes.ProposerSideTxMsg); ok {
+		proposer := validatorSet.GetProposer()
+		if proposer == nil || !bytes.Equal(proposerMsg.GetProposer().Bytes(), proposer.Signer.Bytes()) {
+			k.Logger(ctx).Error("Side tx proposer is not current proposer", "proposer", proposerMsg.GetProposer().String())
+			return types.ErrSideTxNotFromProposer(k.Codespace()).Result()
+		}
+	}
+
+	tx := types.NewPendingSideTx(msg, ctx.BlockHeight())
+	if k.HasPendingSideTx(ctx, tx.ID) {
+		return types.ErrSideTxAlreadyPending(k.Codespace()).Result()
+	}
+
+	if err := k.SetPendingSideTx(ctx, tx); err != nil {
+		return sdk.ErrInternal(err.Error()).Result()
+	}
+	k.Logger(ctx).Debug("Added side tx to await validator votes", "sideTx", tx.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSideTx,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySideTxID, tx.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyTxHash, msg.GetTxHash().String()),
+			sdk.NewAttribute(types.AttributeKeyLogIndex, strconv.FormatUint(msg.GetLogIndex(), 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// handleMsgSideTxVote records validator vote on pending side tx and decides side tx if votes allow it
+// (see decideSideTx).
+func handleMsgSideTxVote(ctx sdk.Context, msg types.MsgSideTxVote, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Validating side tx vote", "TxData", msg)
+
+	tx, err := k.GetPendingSideTx(ctx, msg.ID)
+	if err != nil {
+		k.Logger(ctx).Error("No pending side tx to vote on", "id", msg.ID.String(), "error", err)
+		return types.ErrNoPendingSideTx(k.Codespace()).Result()
+	}
+
+	// voter must be in current validator set
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	_, validator := validatorSet.GetByAddress(msg.From.Bytes())
+	if validator == nil {
+		k.Logger(ctx).Error("Voter is not in current validator set", "voter", msg.From.String())
+		return common.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	if k.HasSideTxVote(ctx, msg.ID, validator.ID) {
+		return types.ErrSideTxAlreadyVoted(k.Codespace()).Result()
+	}
+
+	k.SetSideTxVote(ctx, msg.ID, validator.ID, msg.Vote)
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSideTxVote,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySideTxID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyVoter, msg.From.String()),
+			sdk.NewAttribute(types.AttributeKeyVote, strconv.FormatBool(msg.Vote)),
+		),
+	}
+
+	decision, _ := decideSideTx(ctx, *tx, k)
+	events = append(events, decision...)
+
+	ctx.EventManager().EmitEvents(events)
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// decideSideTx tallies votes of current validators on pending side tx. Side tx is applied once validators
+// with more than 2/3 voting power voted for it, and rejected once that is not possible anymore.
+// It returns false if side tx is still undecided.
+func decideSideTx(ctx sdk.Context, tx types.PendingSideTx, k Keeper) (sdk.Events, bool) {
+	yes, no, total := k.TallySideTxVotes(ctx, tx.ID)
+	k.Logger(ctx).Debug("Side tx votes", "id", tx.ID.String(), "yes", yes, "no", no, "total", total)
+
+	if yes*3 > total*2 {
+		// +2/3 voted for side tx, apply it
+		k.RemovePendingSideTx(ctx, tx.ID)
+		return applySideTx(ctx, tx, k), true
+	}
+
+	if (total-no)*3 <= total*2 {
+		// +1/3 voted against side tx, it can't get +2/3 anymore
+		k.RemovePendingSideTx(ctx, tx.ID)
+		k.Logger(ctx).Info("Side tx rejected by validators", "sideTx", tx.String())
+
+		return sdk.Events{
+			sdk.NewEvent(
+				types.EventTypeSideTxRejected,
+				sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+				sdk.NewAttribute(types.AttributeKeySideTxID, tx.ID.String()),
+				sdk.NewAttribute(types.AttributeKeyMsgType, tx.Msg.Type()),
+			),
+		}, true
+	}
+
+	return nil, false
+}
+
+// applySideTx applies approved side tx msg with its module handler. State changes of failed msg
+// are discarded, failure doesn't fail the vote which approved it.
+func applySideTx(ctx sdk.Context, tx types.PendingSideTx, k Keeper) sdk.Events {
+	result := sdk.ErrUnknownRequest("No post handler for side tx msg").Result()
+	if handler, ok := k.GetPostHandler(tx.Msg.Route()); ok {
+		cacheCtx, writeCache := ctx.CacheContext()
+		result = handler(cacheCtx, tx.Msg)
+		if result.IsOK() {
+			writeCache()
+		}
+	}
+
+	if result.IsOK() {
+		// event is applied, other msgs reporting it are not needed anymore
+		if sideTxMsg, ok := tx.Msg.(hmTypes.SideTxMsg); ok {
+			k.RemoveSideTxsOfEvent(ctx, sideTxMsg)
+		}
+
+		// applied side tx (eg. checkpoint ack moving ack count) can change current validators
+		k.sk.SetValidatorSetChangedFlag(ctx, true)
+		k.Logger(ctx).Info("Side tx approved by validators and applied", "sideTx", tx.String())
+	} else {
+		k.Logger(ctx).Error("Side tx approved by validators but failed to apply", "sideTx", tx.String(), "log", result.Log)
+	}
+
+	events := sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeSideTxApproved,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySideTxID, tx.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, tx.Msg.Type()),
+			sdk.NewAttribute(types.AttributeKeyResultCode, strconv.FormatUint(uint64(result.Code), 10)),
+		),
+	}
+	if result.IsOK() {
+		events = append(events, result.Events...)
+	}
+	return events
+}
+
+// EndBlocker re-tallies votes on pending side txs, as validator set might have changed since last vote,
+// and drops side txs which didn't get decided by votes in time
+func EndBlocker(ctx sdk.Context, k Keeper) {
+	for _, tx := range k.GetPendingSideTxs(ctx) {
+		// side tx might be removed while applying other side tx of the same event
+		if !k.HasPendingSideTx(ctx, tx.ID) {
+			continue
+		}
+
+		if events, decided := decideSideTx(ctx, tx, k); decided {
+			ctx.EventManager().EmitEvents(events)
+			continue
+		}
+
+		if ctx.BlockHeight()-tx.Height < types.PendingSideTxExpiry {
+			continue
+		}
+
+		k.RemovePendingSideTx(ctx, tx.ID)
+		k.Logger(ctx).Info("Side tx expired without validator decision", "sideTx", tx.String())
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeSideTxRejected,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeySideTxID, tx.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyMsgType, tx.Msg.Type()),
+		))
+	}
+}
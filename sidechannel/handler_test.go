@@ -0,0 +1,218 @@
+package sidechannel_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/params"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	"github.com/maticnetwork/heimdall/sidechannel"
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, staking.Keeper, sidechannel.Keeper) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	keyCheckpoint := sdk.NewKVStoreKey(checkpointTypes.StoreKey)
+	keyStaking := sdk.NewKVStoreKey(stakingTypes.StoreKey)
+	keySidechannel := sdk.NewKVStoreKey(types.StoreKey)
+	keyParams := sdk.NewKVStoreKey(paramsTypes.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(paramsTypes.TStoreKey)
+
+	ms.MountStoreWithDB(keyCheckpoint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySidechannel, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid"}, false, log.NewNopLogger())
+	cdc := cmn.MakeTestCodec()
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
+
+	checkpointKeeper := checkpoint.NewKeeper(
+		cdc,
+		keyCheckpoint,
+		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		staking.Keeper{},
+		nil,
+	)
+
+	stakingKeeper := staking.NewKeeper(
+		cdc,
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		cmn.NewModuleCommunicator(checkpointKeeper),
+	)
+
+	sideTxKeeper := sidechannel.NewKeeper(
+		cdc,
+		keySidechannel,
+		common.DefaultCodespace,
+		stakingKeeper,
+	)
+
+	return ctx, stakingKeeper, sideTxKeeper
+}
+
+// newShareMint creates side tx msg reporting share mint event of tx hash 0x123, log index 0
+func newShareMint(from hmTypes.HeimdallAddress, shares int64) stakingTypes.MsgShareMint {
+	delegator := hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000001")
+	return stakingTypes.NewMsgShareMint(from, 1, delegator, hmTypes.NewInt(shares), hmTypes.NewInt(shares), hmTypes.HexToHeimdallHash("123"), 0, 10)
+}
+
+func TestForgedSideTxDoesNotBlockHonestOne(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+	validators := cmn.LoadValidatorSet(4, t, sk, ctx, false, 10).Validators
+
+	var applied []sdk.Msg
+	keeper.SetPostHandler(stakingTypes.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		applied = append(applied, msg)
+		return sdk.Result{}
+	})
+	submit := sidechannel.NewSideTxDecorator(keeper, nil)
+	vote := sidechannel.NewHandler(keeper)
+
+	// forged msg for real event is submitted first
+	forged := newShareMint(validators[0].Signer, 100)
+	require.True(t, submit(ctx, forged).IsOK())
+
+	honest := newShareMint(validators[1].Signer, 10)
+	require.True(t, submit(ctx, honest).IsOK(), "honest msg for the same event must not be blocked")
+	require.False(t, submit(ctx, honest).IsOK(), "same msg is pending once")
+
+	// same content relayed by other validator is the same side tx
+	relayed := newShareMint(validators[2].Signer, 10)
+	require.Equal(t, sdk.CodeType(types.CodeSideTxAlreadyPending), submit(ctx, relayed).Code)
+	require.Len(t, keeper.GetPendingSideTxs(ctx), 2)
+
+	forgedID, honestID := types.GetSideTxID(forged), types.GetSideTxID(honest)
+	for _, validator := range validators[:2] {
+		require.True(t, vote(ctx, types.NewMsgSideTxVote(validator.Signer, forgedID, false)).IsOK())
+	}
+	require.False(t, keeper.HasPendingSideTx(ctx, forgedID), "+1/3 no votes reject forged msg")
+
+	for _, validator := range validators[:3] {
+		require.True(t, vote(ctx, types.NewMsgSideTxVote(validator.Signer, honestID, true)).IsOK())
+	}
+	require.Equal(t, []sdk.Msg{honest}, applied)
+
+	// other msgs of applied event are dropped
+	require.Empty(t, keeper.GetPendingSideTxs(ctx))
+}
+
+func TestRelayedSideTxIsPendingOnce(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+	validators := cmn.LoadValidatorSet(4, t, sk, ctx, false, 10).Validators
+	submit := sidechannel.NewSideTxDecorator(keeper, nil)
+
+	// every validator relays the same state synced event
+	newEventRecord := func(from hmTypes.HeimdallAddress) clerkTypes.MsgEventRecord {
+		contract := hmTypes.HexToHeimdallAddress("0x0000000000000000000000000000000000000002")
+		return clerkTypes.NewMsgEventRecord(from, hmTypes.HexToHeimdallHash("123"), 1, 7, contract, hmTypes.HexBytes("data"), "15001")
+	}
+
+	require.True(t, submit(ctx, newEventRecord(validators[0].Signer)).IsOK())
+	require.False(t, submit(ctx, newEventRecord(validators[1].Signer)).IsOK())
+	require.Equal(t, types.GetSideTxID(newEventRecord(validators[0].Signer)), types.GetSideTxID(newEventRecord(validators[1].Signer)))
+	require.Len(t, keeper.GetPendingSideTxs(ctx), 1)
+}
+
+func TestEndBlockerDecidesOnValidatorSetChange(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+	validatorSet := cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
+	validators := validatorSet.Validators
+
+	var applied []sdk.Msg
+	keeper.SetPostHandler(stakingTypes.RouterKey, func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		applied = append(applied, msg)
+		return sdk.Result{}
+	})
+
+	msg := newShareMint(validators[0].Signer, 10)
+	require.True(t, sidechannel.NewSideTxDecorator(keeper, nil)(ctx, msg).IsOK())
+
+	id := types.GetSideTxID(msg)
+	for _, validator := range validators[:2] {
+		require.True(t, sidechannel.NewHandler(keeper)(ctx, types.NewMsgSideTxVote(validator.Signer, id, true)).IsOK())
+	}
+
+	// half of voting power is not enough
+	sidechannel.EndBlocker(ctx, keeper)
+	require.True(t, keeper.HasPendingSideTx(ctx, id))
+	require.Empty(t, applied)
+
+	// validators which didn't vote leave validator set, existing votes pass 2/3
+	require.NoError(t, sk.UpdateValidatorSetInStore(ctx, *hmTypes.NewValidatorSet([]*hmTypes.Validator{validators[0], validators[1]})))
+	sk.SetValidatorSetChangedFlag(ctx, false)
+	sidechannel.EndBlocker(ctx, keeper)
+	require.False(t, keeper.HasPendingSideTx(ctx, id))
+	require.Equal(t, []sdk.Msg{msg}, applied)
+
+	// validator set is recomputed after side tx applied in end block
+	require.True(t, sk.HasValidatorSetChanged(ctx))
+}
+
+func TestQuerySideTxVote(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+	validators := cmn.LoadValidatorSet(4, t, sk, ctx, false, 10).Validators
+	querier := sidechannel.NewQuerier(keeper)
+
+	msg := newShareMint(validators[0].Signer, 10)
+	require.True(t, sidechannel.NewSideTxDecorator(keeper, nil)(ctx, msg).IsOK())
+	id := types.GetSideTxID(msg)
+
+	query := func(voter hmTypes.HeimdallAddress) (vote types.SideTxVote) {
+		data, err := cmn.MakeTestCodec().MarshalJSON(types.NewQuerySideTxVoteParams(id, voter))
+		require.NoError(t, err)
+		res, sdkErr := querier(ctx, []string{types.QuerySideTxVote}, abci.RequestQuery{Data: data})
+		require.Nil(t, sdkErr)
+		require.NoError(t, json.Unmarshal(res, &vote))
+		return vote
+	}
+
+	require.Equal(t, types.SideTxVote{}, query(validators[0].Signer))
+
+	require.True(t, sidechannel.NewHandler(keeper)(ctx, types.NewMsgSideTxVote(validators[0].Signer, id, false)).IsOK())
+	require.Equal(t, types.SideTxVote{Voted: true, Vote: false}, query(validators[0].Signer))
+	require.Equal(t, types.SideTxVote{}, query(validators[1].Signer))
+}
+
+func TestCheckpointAcceptedOnlyFromProposer(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+	validatorSet := cmn.LoadValidatorSet(4, t, sk, ctx, false, 10)
+	proposer := validatorSet.GetProposer()
+	submit := sidechannel.NewSideTxDecorator(keeper, nil)
+
+	rootHash := hmTypes.HexToHeimdallHash("123")
+	for _, validator := range validatorSet.Validators {
+		if validator.Signer == proposer.Signer {
+			continue
+		}
+
+		// non proposer can't submit real root as its own checkpoint
+		got := submit(ctx, checkpointTypes.NewMsgCheckpointBlock(validator.Signer, 0, 255, rootHash, rootHash, 1))
+		require.Equal(t, sdk.CodeType(types.CodeSideTxNotFromProposer), got.Code)
+	}
+
+	require.True(t, submit(ctx, checkpointTypes.NewMsgCheckpointBlock(proposer.Signer, 0, 255, rootHash, rootHash, 1)).IsOK())
+	require.Len(t, keeper.GetPendingSideTxs(ctx), 1)
+}
@@ -0,0 +1,225 @@
+package sidechannel
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	DefaultValue = []byte{0x01} // Value to store for yes vote
+
+	PendingSideTxPrefixKey = []byte{0x11} // prefix key for side txs awaiting validator votes
+	SideTxVotePrefixKey    = []byte{0x12} // prefix key for validator votes on pending side txs
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// staking keeper
+	sk staking.Keeper
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey sdk.StoreKey
+	// codespace
+	codespace sdk.CodespaceType
+	// handlers which apply approved side tx msgs, by msg route
+	postHandlers map[string]sdk.Handler
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	codespace sdk.CodespaceType,
+	stakingKeeper staking.Keeper,
+) Keeper {
+	keeper := Keeper{
+		cdc:          cdc,
+		storeKey:     storeKey,
+		codespace:    codespace,
+		sk:           stakingKeeper,
+		postHandlers: make(map[string]sdk.Handler),
+	}
+	return keeper
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// SetPostHandler sets handler which applies approved side tx msgs of given route
+func (k Keeper) SetPostHandler(route string, handler sdk.Handler) {
+	k.postHandlers[route] = handler
+}
+
+// GetPostHandler returns handler which applies approved side tx msgs of given route
+func (k Keeper) GetPostHandler(route string) (sdk.Handler, bool) {
+	handler, ok := k.postHandlers[route]
+	return handler, ok
+}
+
+//
+// Pending side txs
+//
+
+// GetPendingSideTxKey appends prefix to side tx id
+func GetPendingSideTxKey(id hmTypes.HeimdallHash) []byte {
+	return append(PendingSideTxPrefixKey, id.Bytes()...)
+}
+
+// SetPendingSideTx stores side tx awaiting validator votes
+func (k *Keeper) SetPendingSideTx(ctx sdk.Context, tx types.PendingSideTx) error {
+	out, err := k.cdc.MarshalBinaryBare(tx)
+	if err != nil {
+		k.Logger(ctx).Error("Error marshalling pending side tx", "error", err)
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(GetPendingSideTxKey(tx.ID), out)
+	return nil
+}
+
+// HasPendingSideTx checks if side tx is awaiting validator votes
+func (k *Keeper) HasPendingSideTx(ctx sdk.Context, id hmTypes.HeimdallHash) bool {
+	return ctx.KVStore(k.storeKey).Has(GetPendingSideTxKey(id))
+}
+
+// GetPendingSideTx returns side tx awaiting validator votes
+func (k *Keeper) GetPendingSideTx(ctx sdk.Context, id hmTypes.HeimdallHash) (*types.PendingSideTx, error) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetPendingSideTxKey(id)
+
+	if !store.Has(key) {
+		return nil, errors.New("No pending side tx found")
+	}
+
+	var tx types.PendingSideTx
+	if err := k.cdc.UnmarshalBinaryBare(store.Get(key), &tx); err != nil {
+		return nil, err
+	}
+	return &tx, nil
+}
+
+// GetPendingSideTxs returns all side txs awaiting validator votes
+func (k *Keeper) GetPendingSideTxs(ctx sdk.Context) (txs []types.PendingSideTx) {
+	iterator := sdk.KVStorePrefixIterator(ctx.KVStore(k.storeKey), PendingSideTxPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var tx types.PendingSideTx
+		if err := k.cdc.UnmarshalBinaryBare(iterator.Value(), &tx); err != nil {
+			k.Logger(ctx).Error("Error unmarshalling pending side tx", "error", err)
+			continue
+		}
+		txs = append(txs, tx)
+	}
+	return txs
+}
+
+// RemovePendingSideTx removes pending side tx along with its votes
+func (k *Keeper) RemovePendingSideTx(ctx sdk.Context, id hmTypes.HeimdallHash) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(GetPendingSideTxKey(id))
+
+	iterator := sdk.KVStorePrefixIterator(store, GetSideTxVotesKey(id))
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RemovePendingSideTxs removes pending side txs of given msg route and type, along with their votes
+func (k *Keeper) RemovePendingSideTxs(ctx sdk.Context, route string, msgType string) {
+	for _, tx := range k.GetPendingSideTxs(ctx) {
+		if tx.Msg.Route() == route && tx.Msg.Type() == msgType {
+			k.RemovePendingSideTx(ctx, tx.ID)
+			k.Logger(ctx).Debug("Removed pending side tx", "sideTx", tx.String())
+		}
+	}
+}
+
+// RemoveSideTxsOfEvent removes pending side txs which report the same mainchain event as given msg,
+// along with their votes
+func (k *Keeper) RemoveSideTxsOfEvent(ctx sdk.Context, msg hmTypes.SideTxMsg) {
+	eventKey := types.GetSideTxEventKey(msg)
+	for _, tx := range k.GetPendingSideTxs(ctx) {
+		sideTxMsg, ok := tx.Msg.(hmTypes.SideTxMsg)
+		if ok && bytes.Equal(types.GetSideTxEventKey(sideTxMsg), eventKey) {
+			k.RemovePendingSideTx(ctx, tx.ID)
+			k.Logger(ctx).Debug("Removed pending side tx of applied event", "sideTx", tx.String())
+		}
+	}
+}
+
+//
+// Side tx votes
+//
+
+// GetSideTxVotesKey appends prefix to side tx id
+func GetSideTxVotesKey(id hmTypes.HeimdallHash) []byte {
+	return append(SideTxVotePrefixKey, id.Bytes()...)
+}
+
+// GetSideTxVoteKey appends prefix to side tx id and validator id
+func GetSideTxVoteKey(id hmTypes.HeimdallHash, validatorID hmTypes.ValidatorID) []byte {
+	return append(GetSideTxVotesKey(id), validatorID.Bytes()...)
+}
+
+// SetSideTxVote stores vote of validator on pending side tx
+func (k *Keeper) SetSideTxVote(ctx sdk.Context, id hmTypes.HeimdallHash, validatorID hmTypes.ValidatorID, vote bool) {
+	value := []byte{0x00}
+	if vote {
+		value = DefaultValue
+	}
+	ctx.KVStore(k.storeKey).Set(GetSideTxVoteKey(id, validatorID), value)
+}
+
+// HasSideTxVote checks if validator has voted on pending side tx
+func (k *Keeper) HasSideTxVote(ctx sdk.Context, id hmTypes.HeimdallHash, validatorID hmTypes.ValidatorID) bool {
+	return ctx.KVStore(k.storeKey).Has(GetSideTxVoteKey(id, validatorID))
+}
+
+// GetSideTxVote returns vote of validator on pending side tx
+func (k *Keeper) GetSideTxVote(ctx sdk.Context, id hmTypes.HeimdallHash, validatorID hmTypes.ValidatorID) (vote bool, ok bool) {
+	store := ctx.KVStore(k.storeKey)
+	key := GetSideTxVoteKey(id, validatorID)
+	if !store.Has(key) {
+		return false, false
+	}
+	return bytes.Equal(store.Get(key), DefaultValue), true
+}
+
+// TallySideTxVotes returns voting power of current validators which voted for and against pending side tx
+func (k *Keeper) TallySideTxVotes(ctx sdk.Context, id hmTypes.HeimdallHash) (yes int64, no int64, total int64) {
+	validatorSet := k.sk.GetValidatorSet(ctx)
+	for _, validator := range validatorSet.Validators {
+		vote, ok := k.GetSideTxVote(ctx, id, validator.ID)
+		if !ok {
+			continue
+		}
+		if vote {
+			yes += validator.VotingPower
+		} else {
+			no += validator.VotingPower
+		}
+	}
+	return yes, no, validatorSet.TotalVotingPower()
+}
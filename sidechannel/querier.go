@@ -0,0 +1,58 @@
+package sidechannel
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/sidechannel/types"
+)
+
+// NewQuerier creates a querier for sidechannel REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryPendingSideTxs:
+			return handleQueryPendingSideTxs(ctx, req, keeper)
+		case types.QuerySideTxVote:
+			return handleQuerySideTxVote(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown sidechannel query endpoint")
+		}
+	}
+}
+
+func handleQueryPendingSideTxs(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	txs := keeper.GetPendingSideTxs(ctx)
+	if txs == nil {
+		txs = make([]types.PendingSideTx, 0)
+	}
+
+	// pending msgs are interfaces, use codec to keep their types
+	bz, err := keeper.cdc.MarshalJSON(txs)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySideTxVote(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QuerySideTxVoteParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	var result types.SideTxVote
+	if validator, err := keeper.sk.GetValidatorInfo(ctx, params.Voter.Bytes()); err == nil {
+		result.Vote, result.Voted = keeper.GetSideTxVote(ctx, params.ID, validator.ID)
+	}
+
+	// json record
+	bz, err := json.Marshal(result)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
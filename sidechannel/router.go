@@ -0,0 +1,30 @@
+package sidechannel
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Router wraps app router. Handlers of all modules are decorated, so side tx msgs are added
+// to pending side txs, and are registered as post handlers which apply approved side tx msgs.
+type Router struct {
+	sdk.Router
+
+	keeper Keeper
+}
+
+var _ sdk.Router = Router{}
+
+// NewRouter creates side tx router wrapping app router
+func NewRouter(router sdk.Router, keeper Keeper) Router {
+	return Router{
+		Router: router,
+		keeper: keeper,
+	}
+}
+
+// AddRoute registers decorated module handler for route
+func (r Router) AddRoute(path string, handler sdk.Handler) sdk.Router {
+	r.keeper.SetPostHandler(path, handler)
+	r.Router.AddRoute(path, NewSideTxDecorator(r.keeper, handler))
+	return r
+}
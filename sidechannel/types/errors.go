@@ -0,0 +1,39 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Side channel errors reserve 6400 ~ 6499.
+const (
+	CodeSideTxAlreadyPending   sdk.CodeType = 6400
+	CodeNoPendingSideTx                     = 6401
+	CodeSideTxAlreadyVoted                  = 6402
+	CodeSideTxNotFromValidator              = 6403
+	CodeSideTxNotFromProposer               = 6404
+)
+
+// ErrSideTxAlreadyPending represents side tx which is already waiting for votes
+func ErrSideTxAlreadyPending(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxAlreadyPending, "Side tx is already pending validator votes")
+}
+
+// ErrNoPendingSideTx represents vote on unknown side tx
+func ErrNoPendingSideTx(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeNoPendingSideTx, "No pending side tx found")
+}
+
+// ErrSideTxAlreadyVoted represents duplicate vote of validator
+func ErrSideTxAlreadyVoted(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxAlreadyVoted, "Validator has already voted on side tx")
+}
+
+// ErrSideTxNotFromValidator represents side tx msg sent by non validator
+func ErrSideTxNotFromValidator(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxNotFromValidator, "Side tx must be submitted by validator in current validator set")
+}
+
+// ErrSideTxNotFromProposer represents side tx msg which has to be proposed by current proposer
+func ErrSideTxNotFromProposer(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeSideTxNotFromProposer, "Side tx must be proposed by current proposer")
+}
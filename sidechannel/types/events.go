@@ -0,0 +1,18 @@
+package types
+
+var (
+	EventTypeSideTx         = "side-tx"
+	EventTypeSideTxVote     = "side-tx-vote"
+	EventTypeSideTxApproved = "side-tx-approved"
+	EventTypeSideTxRejected = "side-tx-rejected"
+
+	AttributeKeySideTxID   = "side-tx-id"
+	AttributeKeyMsgType    = "msg-type"
+	AttributeKeyTxHash     = "tx-hash"
+	AttributeKeyLogIndex   = "log-index"
+	AttributeKeyVoter      = "voter"
+	AttributeKeyVote       = "vote"
+	AttributeKeyResultCode = "result-code"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,16 @@
+package types
+
+// GenesisState is the sidechannel state that must be provided at genesis.
+// Pending side txs are not exported, mainchain events are re-submitted by bridge.
+type GenesisState struct{}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return GenesisState{}
+}
+
+// ValidateGenesis performs basic validation of sidechannel genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return nil
+}
@@ -0,0 +1,55 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/types"
+)
+
+// MsgSideTxVote validator vote on pending side tx
+type MsgSideTxVote struct {
+	From types.HeimdallAddress `json:"from"`
+	ID   types.HeimdallHash    `json:"id"`
+	Vote bool                  `json:"vote"`
+}
+
+var _ sdk.Msg = MsgSideTxVote{}
+
+// NewMsgSideTxVote creates new side tx vote
+func NewMsgSideTxVote(from types.HeimdallAddress, id types.HeimdallHash, vote bool) MsgSideTxVote {
+	return MsgSideTxVote{
+		From: from,
+		ID:   id,
+		Vote: vote,
+	}
+}
+
+// Route Implements Msg.
+func (msg MsgSideTxVote) Route() string { return RouterKey }
+
+// Type Implements Msg.
+func (msg MsgSideTxVote) Type() string { return "side-tx-vote" }
+
+// ValidateBasic Implements Msg.
+func (msg MsgSideTxVote) ValidateBasic() sdk.Error {
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	if msg.ID.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid side tx id %v", msg.ID.String())
+	}
+
+	return nil
+}
+
+// GetSignBytes Implements Msg.
+func (msg MsgSideTxVote) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners Implements Msg.
+func (msg MsgSideTxVote) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{types.HeimdallAddressToAccAddress(msg.From)}
+}
@@ -0,0 +1,28 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the sidechannel Querier
+const (
+	QueryPendingSideTxs = "pending-side-txs"
+	QuerySideTxVote     = "side-tx-vote"
+)
+
+// QuerySideTxVoteParams defines the params for querying vote of validator on pending side tx
+type QuerySideTxVoteParams struct {
+	ID    hmTypes.HeimdallHash    `json:"id"`
+	Voter hmTypes.HeimdallAddress `json:"voter"`
+}
+
+// NewQuerySideTxVoteParams creates a new instance of QuerySideTxVoteParams.
+func NewQuerySideTxVoteParams(id hmTypes.HeimdallHash, voter hmTypes.HeimdallAddress) QuerySideTxVoteParams {
+	return QuerySideTxVoteParams{ID: id, Voter: voter}
+}
+
+// SideTxVote vote of validator on pending side tx, Voted is false if validator hasn't voted yet
+type SideTxVote struct {
+	Voted bool `json:"voted"`
+	Vote  bool `json:"vote"`
+}
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/tendermint/tendermint/crypto/tmhash"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// PendingSideTx side tx msg which is waiting for validator votes
+type PendingSideTx struct {
+	ID     hmTypes.HeimdallHash `json:"id"`
+	Msg    sdk.Msg              `json:"msg"`
+	Height int64                `json:"height"`
+}
+
+// NewPendingSideTx creates new pending side tx for msg submitted at given height
+func NewPendingSideTx(msg hmTypes.SideTxMsg, height int64) PendingSideTx {
+	return PendingSideTx{
+		ID:     GetSideTxID(msg),
+		Msg:    msg,
+		Height: height,
+	}
+}
+
+func (tx PendingSideTx) String() string {
+	return fmt.Sprintf("PendingSideTx{%v %v %v}", tx.ID.String(), tx.Msg.Type(), tx.Height)
+}
+
+// GetSideTxID returns id of side tx msg, ie. hash of mainchain event it reports and of msg content
+// (without sender). Msgs reporting the same event with different content are separate side txs voted
+// on separately, so msg forged for real event can't block honest one, while relays of the same
+// event by different validators are one side tx.
+func GetSideTxID(msg hmTypes.SideTxMsg) hmTypes.HeimdallHash {
+	data := GetSideTxEventKey(msg)
+	data = append(data, tmhash.Sum(msg.GetSideSignBytes())...)
+	return hmTypes.BytesToHeimdallHash(tmhash.Sum(data))
+}
+
+// GetSideTxEventKey returns key of mainchain event side tx msg reports, ie. msg route, type,
+// tx hash and log index. It is shared by all side txs reporting the same event.
+func GetSideTxEventKey(msg hmTypes.SideTxMsg) []byte {
+	data := []byte(msg.Route() + "/" + msg.Type() + "/")
+	data = append(data, msg.GetTxHash().Bytes()...)
+	return append(data, sdk.Uint64ToBigEndian(msg.GetLogIndex())...)
+}
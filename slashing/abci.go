@@ -0,0 +1,24 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+)
+
+// BeginBlocker tracks validator liveness from last commit, and slashes and jails validators
+// for byzantine behaviour reported by tendermint
+func BeginBlocker(ctx sdk.Context, req abci.RequestBeginBlock, k Keeper) {
+	for _, vote := range req.LastCommitInfo.GetVotes() {
+		k.HandleValidatorSignature(ctx, vote.Validator.Address, vote.SignedLastBlock)
+	}
+
+	for _, evidence := range req.ByzantineValidators {
+		switch evidence.Type {
+		case tmtypes.ABCIEvidenceTypeDuplicateVote:
+			k.HandleDoubleSign(ctx, evidence)
+		default:
+			k.Logger(ctx).Error("Ignored unknown evidence type", "type", evidence.Type)
+		}
+	}
+}
@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	hmClient "github.com/maticnetwork/heimdall/client"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/version"
+)
+
+// GetQueryCmd returns the cli query commands for this module
+func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
+	queryCmds := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the slashing module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       hmClient.ValidateCmd,
+	}
+
+	queryCmds.AddCommand(
+		client.GetCommands(
+			GetSlashRecords(cdc),
+			GetSigningInfo(cdc),
+			GetQueryParams(cdc),
+		)...,
+	)
+
+	return queryCmds
+}
+
+// GetSlashRecords get slash records of validator
+func GetSlashRecords(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "slash-records",
+		Short: "show slash records of validator",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			// get query params
+			validatorID := hmTypes.NewValidatorID(viper.GetUint64(FlagValidatorID))
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(validatorID))
+			if err != nil {
+				return err
+			}
+
+			// fetch slash records
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySlashRecords), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator-id>")
+	cmd.MarkFlagRequired(FlagValidatorID)
+
+	return cmd
+}
+
+// GetSigningInfo get liveness signing info of validator
+func GetSigningInfo(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "signing-info",
+		Short: "show signing info of validator (all validators if id is not given)",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			if !cmd.Flags().Changed(FlagValidatorID) {
+				res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfos), nil)
+				if err != nil {
+					return err
+				}
+
+				fmt.Println(string(res))
+				return nil
+			}
+
+			// get query params
+			validatorID := hmTypes.NewValidatorID(viper.GetUint64(FlagValidatorID))
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(validatorID))
+			if err != nil {
+				return err
+			}
+
+			// fetch signing info
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySigningInfo), queryParams)
+			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
+				return errors.New("Signing info not found")
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+	cmd.Flags().Uint64(FlagValidatorID, 0, "--id=<validator-id>")
+
+	return cmd
+}
+
+// GetQueryParams implements the params query command.
+func GetQueryParams(cdc *codec.Codec) *cobra.Command {
+	return &cobra.Command{
+		Use:   "params",
+		Args:  cobra.NoArgs,
+		Short: "show the current slashing parameters information",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query values set as slashing parameters.
+
+Example:
+$ %s query slashing params
+`,
+				version.ClientName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+
+			route := fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryParams)
+			bz, _, err := cliCtx.QueryWithData(route, nil)
+			if err != nil {
+				return err
+			}
+
+			var params types.Params
+			json.Unmarshal(bz, &params)
+			return cliCtx.PrintOutput(params)
+		},
+	}
+}
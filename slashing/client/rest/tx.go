@@ -0,0 +1,47 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gorilla/mux"
+
+	restClient "github.com/maticnetwork/heimdall/client/rest"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+	"github.com/maticnetwork/heimdall/types/rest"
+)
+
+func registerTxRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc("/slashing/unjail", unjailHandlerFn(cliCtx)).Methods("POST")
+}
+
+// UnjailReq defines the properties of a unjail request's body.
+type UnjailReq struct {
+	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+	ID uint64 `json:"id" yaml:"id"`
+}
+
+// unjailHandlerFn generates unjail tx for validator
+func unjailHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UnjailReq
+		if !rest.ReadRESTReq(w, r, cliCtx.Codec, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		// draft a message and send response
+		msg := types.NewMsgUnjail(
+			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
+			req.ID,
+		)
+		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
+	}
+}
@@ -0,0 +1,48 @@
+package slashing
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// InitGenesis sets slashing information for genesis.
+func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
+	keeper.SetParams(ctx, data.Params)
+
+	for _, record := range data.SlashRecords {
+		keeper.SetSlashRecord(ctx, record)
+	}
+
+	for _, signingInfo := range data.SigningInfos {
+		keeper.SetSigningInfo(ctx, signingInfo)
+	}
+
+	for _, missedBlocks := range data.MissedBlocks {
+		for _, index := range missedBlocks.Indexes {
+			keeper.setMissedBlock(ctx, missedBlocks.ValidatorID, index, true)
+		}
+	}
+}
+
+// ExportGenesis returns a GenesisState for a given context and keeper.
+func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
+	signingInfos := keeper.GetAllSigningInfos(ctx)
+
+	var missedBlocks []types.ValidatorMissedBlocks
+	for _, signingInfo := range signingInfos {
+		if indexes := keeper.GetMissedBlocks(ctx, signingInfo.ValidatorID); len(indexes) > 0 {
+			missedBlocks = append(missedBlocks, types.ValidatorMissedBlocks{
+				ValidatorID: signingInfo.ValidatorID,
+				Indexes:     indexes,
+			})
+		}
+	}
+
+	return types.NewGenesisState(
+		keeper.GetParams(ctx),
+		keeper.GetAllSlashRecords(ctx),
+		signingInfos,
+		missedBlocks,
+	)
+}
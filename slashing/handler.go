@@ -0,0 +1,63 @@
+package slashing
+
+import (
+	"bytes"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// NewHandler creates new handler for handling messages for slashing module.
+// Validators are slashed and jailed from evidence and last commit in begin blocker.
+func NewHandler(k Keeper) sdk.Handler {
+	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
+		ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+		switch msg := msg.(type) {
+		case types.MsgUnjail:
+			return handleMsgUnjail(ctx, msg, k)
+		default:
+			return sdk.ErrTxDecode("Invalid message in slashing module").Result()
+		}
+	}
+}
+
+// handleMsgUnjail unjails validator jailed for downtime. Validator is added back to validator set by end blocker.
+func handleMsgUnjail(ctx sdk.Context, msg types.MsgUnjail, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling unjail", "validatorID", msg.ID, "from", msg.From.String())
+
+	validator, ok := k.sk.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Unable to fetch validator", "validatorID", msg.ID)
+		return common.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	// only validator signer can unjail it
+	if !bytes.Equal(validator.Signer.Bytes(), msg.From.Bytes()) {
+		k.Logger(ctx).Error("Unjail is not sent by validator signer", "validatorID", msg.ID, "from", msg.From.String())
+		return common.ErrValSignerMismatch(k.Codespace()).Result()
+	}
+
+	if err := k.Unjail(ctx, msg.ID); err != nil {
+		k.Logger(ctx).Error("Unable to unjail validator", "validatorID", msg.ID, "error", err)
+		return err.Result()
+	}
+
+	k.Logger(ctx).Info("Unjailed validator", "validatorID", msg.ID)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeUnjail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(uint64(msg.ID), 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorSigner, validator.Signer.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
@@ -0,0 +1,424 @@
+package slashing
+
+import (
+	"encoding/binary"
+	"math/big"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/params/subspace"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/staking"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var (
+	DefaultValue = []byte{0x01} // Value to store for flags and missed blocks
+
+	SlashRecordPrefixKey = []byte{0x11} // prefix key for slash records
+	JailedInBlockKey     = []byte{0x12} // transient key, set when validator is jailed in current block
+	SigningInfoPrefixKey = []byte{0x13} // prefix key for validator signing info
+	MissedBlockPrefixKey = []byte{0x14} // prefix key for missed block indexes of validator in signed blocks window
+)
+
+// Keeper stores all related data
+type Keeper struct {
+	cdc *codec.Codec
+	// staking keeper
+	sk staking.Keeper
+	// The (unexposed) keys used to access the stores from the Context.
+	storeKey  sdk.StoreKey
+	tStoreKey sdk.StoreKey
+	// param space
+	paramSpace subspace.Subspace
+	// codespace
+	codespace sdk.CodespaceType
+}
+
+// NewKeeper create new keeper
+func NewKeeper(
+	cdc *codec.Codec,
+	storeKey sdk.StoreKey,
+	tStoreKey sdk.StoreKey,
+	paramSpace subspace.Subspace,
+	codespace sdk.CodespaceType,
+	stakingKeeper staking.Keeper,
+) Keeper {
+	keeper := Keeper{
+		cdc:        cdc,
+		storeKey:   storeKey,
+		tStoreKey:  tStoreKey,
+		paramSpace: paramSpace.WithKeyTable(types.ParamKeyTable()),
+		codespace:  codespace,
+		sk:         stakingKeeper,
+	}
+	return keeper
+}
+
+// Codespace returns the codespace
+func (k Keeper) Codespace() sdk.CodespaceType {
+	return k.codespace
+}
+
+// Logger returns a module-specific logger
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", types.ModuleName)
+}
+
+// GetSlashRecordKey returns key for slash record of validator at height
+func GetSlashRecordKey(valID hmTypes.ValidatorID, height int64) []byte {
+	return append(GetSlashRecordsPrefix(valID), sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetSlashRecordsPrefix returns prefix key for slash records of validator
+func GetSlashRecordsPrefix(valID hmTypes.ValidatorID) []byte {
+	return append(SlashRecordPrefixKey, sdk.Uint64ToBigEndian(uint64(valID))...)
+}
+
+// GetSigningInfoKey returns key for signing info of validator
+func GetSigningInfoKey(valID hmTypes.ValidatorID) []byte {
+	return append(SigningInfoPrefixKey, sdk.Uint64ToBigEndian(uint64(valID))...)
+}
+
+// GetMissedBlockKey returns key for missed block of validator at index in signed blocks window
+func GetMissedBlockKey(valID hmTypes.ValidatorID, index int64) []byte {
+	return append(GetMissedBlocksPrefix(valID), sdk.Uint64ToBigEndian(uint64(index))...)
+}
+
+// GetMissedBlocksPrefix returns prefix key for missed blocks of validator
+func GetMissedBlocksPrefix(valID hmTypes.ValidatorID) []byte {
+	return append(MissedBlockPrefixKey, sdk.Uint64ToBigEndian(uint64(valID))...)
+}
+
+// HandleDoubleSign slashes and jails validator which signed conflicting votes at given height.
+// Jailed validator is removed from validator set by end blocker.
+func (k *Keeper) HandleDoubleSign(ctx sdk.Context, evidence abci.Evidence) {
+	validator, err := k.sk.GetValidatorInfo(ctx, evidence.Validator.Address)
+	if err != nil {
+		k.Logger(ctx).Error("Unknown validator in double sign evidence", "address", hmTypes.BytesToHeimdallAddress(evidence.Validator.Address).String())
+		return
+	}
+
+	params := k.GetParams(ctx)
+
+	// evidence which is too old is ignored
+	if age := ctx.BlockHeader().Time.Sub(evidence.Time); age > params.MaxEvidenceAge {
+		k.Logger(ctx).Info("Ignoring double sign evidence older than max evidence age",
+			"validatorID", validator.ID,
+			"height", evidence.Height,
+			"age", age,
+			"maxEvidenceAge", params.MaxEvidenceAge,
+		)
+		return
+	}
+
+	// validator is already punished for double sign
+	signingInfo, found := k.GetSigningInfo(ctx, validator.ID)
+	if !found {
+		signingInfo = types.NewValidatorSigningInfo(validator.ID, params.SignedBlocksWindow, ctx.BlockHeight())
+	}
+
+	if signingInfo.Tombstoned {
+		k.Logger(ctx).Info("Ignoring double sign evidence of tombstoned validator", "validatorID", validator.ID)
+		return
+	}
+
+	// slash fraction of stake recorded for validator (power reported in evidence comes from consensus)
+	power := validator.VotingPower
+	stake := hmTypes.NewIntFromBigInt(new(big.Int).Mul(big.NewInt(power), hmTypes.CoinDecimals))
+	amount := params.SlashFractionDoubleSign.MulInt(stake).TruncateInt().BigInt()
+
+	if err := k.sk.AddSlashToDividendAccount(ctx, validator.ID, amount); err != nil {
+		k.Logger(ctx).Error("Unable to add slashed amount to dividend account", "validatorID", validator.ID, "error", err)
+		return
+	}
+
+	if err := k.sk.JailValidator(ctx, validator.ID); err != nil {
+		k.Logger(ctx).Error("Unable to jail validator", "validatorID", validator.ID, "error", err)
+		return
+	}
+
+	// validator jailed for double sign can't be unjailed
+	signingInfo.Tombstoned = true
+	k.SetSigningInfo(ctx, signingInfo)
+
+	k.SetSlashRecord(ctx, types.NewSlashRecord(validator.ID, types.SlashReasonDoubleSign, evidence.Height, power, amount.String()))
+	k.SetJailedInBlock(ctx)
+
+	k.Logger(ctx).Info("Slashed and jailed validator for double sign",
+		"validatorID", validator.ID,
+		"height", evidence.Height,
+		"power", power,
+		"amount", amount.String(),
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSlash,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(uint64(validator.ID), 10)),
+		sdk.NewAttribute(types.AttributeKeyValidatorSigner, validator.Signer.String()),
+		sdk.NewAttribute(types.AttributeKeyPower, strconv.FormatInt(power, 10)),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyReason, types.SlashReasonDoubleSign),
+		sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(evidence.Height, 10)),
+	))
+}
+
+// HandleValidatorSignature tracks whether validator signed last block in signed blocks window,
+// jailing validator which missed too many blocks in window.
+func (k *Keeper) HandleValidatorSignature(ctx sdk.Context, address []byte, signed bool) {
+	validator, err := k.sk.GetValidatorInfo(ctx, address)
+	if err != nil {
+		k.Logger(ctx).Error("Unknown validator in last commit", "address", hmTypes.BytesToHeimdallAddress(address).String())
+		return
+	}
+
+	// jailed validator is removed from validator set by end blocker of the same block
+	if validator.Jailed {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	height := ctx.BlockHeight()
+
+	signingInfo, found := k.GetSigningInfo(ctx, validator.ID)
+	if !found {
+		signingInfo = types.NewValidatorSigningInfo(validator.ID, params.SignedBlocksWindow, height)
+	}
+
+	// missed block indexes are only meaningful for window they were recorded in,
+	// start new window if window size got changed through governance
+	if signingInfo.SignedBlocksWindow != params.SignedBlocksWindow {
+		k.resetSignedBlocksWindow(ctx, &signingInfo, params.SignedBlocksWindow, height)
+	}
+
+	// update missed blocks counter with block at current index in window
+	index := signingInfo.IndexOffset % params.SignedBlocksWindow
+	signingInfo.IndexOffset++
+
+	previous := k.getMissedBlock(ctx, validator.ID, index)
+	missed := !signed
+	switch {
+	case !previous && missed:
+		k.setMissedBlock(ctx, validator.ID, index, true)
+		signingInfo.MissedBlocksCounter++
+	case previous && !missed:
+		k.setMissedBlock(ctx, validator.ID, index, false)
+		signingInfo.MissedBlocksCounter--
+	}
+
+	if missed {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeLiveness,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(uint64(validator.ID), 10)),
+			sdk.NewAttribute(types.AttributeKeyMissedBlocks, strconv.FormatInt(signingInfo.MissedBlocksCounter, 10)),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(height, 10)),
+		))
+
+		k.Logger(ctx).Debug("Validator missed block", "validatorID", validator.ID, "missed", signingInfo.MissedBlocksCounter, "height", height)
+	}
+
+	// jail validator which signed less than minimum blocks once window is filled
+	maxMissed := params.SignedBlocksWindow - params.MinSignedBlocks()
+	if height > signingInfo.StartHeight+params.SignedBlocksWindow && signingInfo.MissedBlocksCounter > maxMissed {
+		if err := k.sk.JailValidator(ctx, validator.ID); err != nil {
+			k.Logger(ctx).Error("Unable to jail validator", "validatorID", validator.ID, "error", err)
+			return
+		}
+
+		signingInfo.JailedUntil = ctx.BlockHeader().Time.Add(params.DowntimeJailDuration)
+		k.resetSignedBlocksWindow(ctx, &signingInfo, params.SignedBlocksWindow, height)
+		k.SetJailedInBlock(ctx)
+
+		k.Logger(ctx).Info("Jailed validator for downtime",
+			"validatorID", validator.ID,
+			"height", height,
+			"jailedUntil", signingInfo.JailedUntil,
+		)
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeJail,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(uint64(validator.ID), 10)),
+			sdk.NewAttribute(types.AttributeKeyValidatorSigner, validator.Signer.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, types.JailReasonDowntime),
+			sdk.NewAttribute(types.AttributeKeyJailedUntil, signingInfo.JailedUntil.String()),
+		))
+	}
+
+	k.SetSigningInfo(ctx, signingInfo)
+}
+
+// Unjail unjails validator jailed for downtime once its jail period is over
+func (k *Keeper) Unjail(ctx sdk.Context, valID hmTypes.ValidatorID) sdk.Error {
+	validator, ok := k.sk.GetValidatorFromValID(ctx, valID)
+	if !ok {
+		return hmCommon.ErrNoValidator(k.Codespace())
+	}
+
+	if !validator.Jailed {
+		return types.ErrValidatorNotJailed(k.Codespace())
+	}
+
+	params := k.GetParams(ctx)
+	signingInfo, found := k.GetSigningInfo(ctx, valID)
+	if !found {
+		signingInfo = types.NewValidatorSigningInfo(valID, params.SignedBlocksWindow, ctx.BlockHeight())
+	}
+
+	if signingInfo.Tombstoned {
+		return types.ErrValidatorTombstoned(k.Codespace())
+	}
+
+	if ctx.BlockHeader().Time.Before(signingInfo.JailedUntil) {
+		return types.ErrJailPeriodNotOver(k.Codespace())
+	}
+
+	if err := k.sk.UnjailValidator(ctx, valID); err != nil {
+		return hmCommon.ErrValidatorSave(k.Codespace())
+	}
+
+	// start liveness tracking from scratch
+	k.resetSignedBlocksWindow(ctx, &signingInfo, params.SignedBlocksWindow, ctx.BlockHeight())
+	k.SetSigningInfo(ctx, signingInfo)
+	return nil
+}
+
+// resetSignedBlocksWindow clears missed blocks of validator, starting new window of given size at given height
+func (k *Keeper) resetSignedBlocksWindow(ctx sdk.Context, signingInfo *types.ValidatorSigningInfo, window int64, height int64) {
+	k.clearMissedBlocks(ctx, signingInfo.ValidatorID)
+	signingInfo.SignedBlocksWindow = window
+	signingInfo.StartHeight = height
+	signingInfo.IndexOffset = 0
+	signingInfo.MissedBlocksCounter = 0
+}
+
+// SetSigningInfo stores validator signing info
+func (k *Keeper) SetSigningInfo(ctx sdk.Context, signingInfo types.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSigningInfoKey(signingInfo.ValidatorID), k.cdc.MustMarshalBinaryBare(signingInfo))
+}
+
+// GetSigningInfo returns validator signing info
+func (k *Keeper) GetSigningInfo(ctx sdk.Context, valID hmTypes.ValidatorID) (signingInfo types.ValidatorSigningInfo, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetSigningInfoKey(valID))
+	if bz == nil {
+		return signingInfo, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &signingInfo)
+	return signingInfo, true
+}
+
+// GetAllSigningInfos returns signing info of all validators
+func (k *Keeper) GetAllSigningInfos(ctx sdk.Context) (signingInfos []types.ValidatorSigningInfo) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, SigningInfoPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var signingInfo types.ValidatorSigningInfo
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &signingInfo)
+		signingInfos = append(signingInfos, signingInfo)
+	}
+	return
+}
+
+// GetMissedBlocks returns missed block indexes of validator in current window
+func (k *Keeper) GetMissedBlocks(ctx sdk.Context, valID hmTypes.ValidatorID) (indexes []int64) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetMissedBlocksPrefix(valID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		indexes = append(indexes, int64(binary.BigEndian.Uint64(iterator.Key()[len(prefix):])))
+	}
+	return
+}
+
+// getMissedBlock returns true if validator missed block at index in window.
+// Only missed blocks are stored.
+func (k *Keeper) getMissedBlock(ctx sdk.Context, valID hmTypes.ValidatorID, index int64) bool {
+	return ctx.KVStore(k.storeKey).Has(GetMissedBlockKey(valID, index))
+}
+
+func (k *Keeper) setMissedBlock(ctx sdk.Context, valID hmTypes.ValidatorID, index int64, missed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if missed {
+		store.Set(GetMissedBlockKey(valID, index), DefaultValue)
+	} else {
+		store.Delete(GetMissedBlockKey(valID, index))
+	}
+}
+
+func (k *Keeper) clearMissedBlocks(ctx sdk.Context, valID hmTypes.ValidatorID) {
+	for _, index := range k.GetMissedBlocks(ctx, valID) {
+		k.setMissedBlock(ctx, valID, index, false)
+	}
+}
+
+// SetJailedInBlock marks that validator got jailed in current block
+func (k *Keeper) SetJailedInBlock(ctx sdk.Context) {
+	ctx.TransientStore(k.tStoreKey).Set(JailedInBlockKey, DefaultValue)
+}
+
+// HasJailedInBlock returns true if any validator got jailed in current block
+func (k *Keeper) HasJailedInBlock(ctx sdk.Context) bool {
+	return ctx.TransientStore(k.tStoreKey).Has(JailedInBlockKey)
+}
+
+// SetSlashRecord stores slash record
+func (k *Keeper) SetSlashRecord(ctx sdk.Context, record types.SlashRecord) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(GetSlashRecordKey(record.ValidatorID, record.Height), k.cdc.MustMarshalBinaryBare(record))
+}
+
+// GetSlashRecords returns slash records of validator
+func (k *Keeper) GetSlashRecords(ctx sdk.Context, valID hmTypes.ValidatorID) (records []types.SlashRecord) {
+	k.iterateSlashRecordsByPrefix(ctx, GetSlashRecordsPrefix(valID), func(record types.SlashRecord) {
+		records = append(records, record)
+	})
+	return
+}
+
+// GetAllSlashRecords returns slash records of all validators
+func (k *Keeper) GetAllSlashRecords(ctx sdk.Context) (records []types.SlashRecord) {
+	k.iterateSlashRecordsByPrefix(ctx, SlashRecordPrefixKey, func(record types.SlashRecord) {
+		records = append(records, record)
+	})
+	return
+}
+
+func (k *Keeper) iterateSlashRecordsByPrefix(ctx sdk.Context, prefix []byte, f func(record types.SlashRecord)) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.SlashRecord
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &record)
+		f(record)
+	}
+}
+
+// -----------------------------------------------------------------------------
+// Params
+
+// SetParams sets the slashing module's parameters.
+func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// GetParams gets the slashing module's parameters.
+func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return
+}
@@ -0,0 +1,199 @@
+package slashing_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	"github.com/tendermint/tendermint/libs/log"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/maticnetwork/heimdall/checkpoint"
+	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	"github.com/maticnetwork/heimdall/common"
+	"github.com/maticnetwork/heimdall/params"
+	paramsTypes "github.com/maticnetwork/heimdall/params/types"
+	"github.com/maticnetwork/heimdall/slashing"
+	"github.com/maticnetwork/heimdall/slashing/types"
+	"github.com/maticnetwork/heimdall/staking"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
+	cmn "github.com/maticnetwork/heimdall/test"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func createTestInput(t *testing.T) (sdk.Context, staking.Keeper, slashing.Keeper) {
+	db := dbm.NewMemDB()
+	ms := store.NewCommitMultiStore(db)
+
+	keyCheckpoint := sdk.NewKVStoreKey(checkpointTypes.StoreKey)
+	keyStaking := sdk.NewKVStoreKey(stakingTypes.StoreKey)
+	keySlashing := sdk.NewKVStoreKey(types.StoreKey)
+	tKeySlashing := sdk.NewTransientStoreKey(types.TStoreKey)
+	keyParams := sdk.NewKVStoreKey(paramsTypes.StoreKey)
+	tKeyParams := sdk.NewTransientStoreKey(paramsTypes.TStoreKey)
+
+	ms.MountStoreWithDB(keyCheckpoint, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keyStaking, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(keySlashing, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeySlashing, sdk.StoreTypeTransient, db)
+	ms.MountStoreWithDB(keyParams, sdk.StoreTypeIAVL, db)
+	ms.MountStoreWithDB(tKeyParams, sdk.StoreTypeTransient, db)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	ctx := sdk.NewContext(ms, abci.Header{ChainID: "foochainid", Time: time.Now().UTC()}, false, log.NewNopLogger())
+	cdc := cmn.MakeTestCodec()
+	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
+
+	checkpointKeeper := checkpoint.NewKeeper(
+		cdc,
+		keyCheckpoint,
+		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		staking.Keeper{},
+		nil,
+	)
+
+	stakingKeeper := staking.NewKeeper(
+		cdc,
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		common.DefaultCodespace,
+		cmn.NewModuleCommunicator(checkpointKeeper),
+	)
+
+	slashingKeeper := slashing.NewKeeper(
+		cdc,
+		keySlashing,
+		tKeySlashing,
+		paramsKeeper.Subspace(types.DefaultParamspace),
+		types.DefaultCodespace,
+		stakingKeeper,
+	)
+	slashingKeeper.SetParams(ctx, types.NewParams(types.DefaultSlashFractionDoubleSign, 10, hmTypes.NewDecWithPrec(5, 1), time.Minute, time.Minute))
+
+	return ctx, stakingKeeper, slashingKeeper
+}
+
+func TestHandleValidatorSignature(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+	validator := cmn.GenRandomVal(1, 0, 10, uint64(10), false, 1)[0]
+	require.NoError(t, sk.AddValidator(ctx, validator))
+
+	// sign full window
+	height := int64(1)
+	for ; height <= 10; height++ {
+		keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), validator.Signer.Bytes(), true)
+	}
+
+	// miss half of window, still allowed
+	for ; height <= 15; height++ {
+		keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), validator.Signer.Bytes(), false)
+	}
+
+	signingInfo, found := keeper.GetSigningInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, int64(5), signingInfo.MissedBlocksCounter)
+	require.Len(t, keeper.GetMissedBlocks(ctx, validator.ID), 5)
+	require.False(t, keeper.HasJailedInBlock(ctx))
+
+	// one more missed block jails validator
+	keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), validator.Signer.Bytes(), false)
+
+	jailed, ok := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, ok)
+	require.True(t, jailed.Jailed)
+	require.True(t, keeper.HasJailedInBlock(ctx))
+
+	signingInfo, _ = keeper.GetSigningInfo(ctx, validator.ID)
+	require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
+	require.Empty(t, keeper.GetMissedBlocks(ctx, validator.ID))
+	require.True(t, ctx.BlockHeader().Time.Add(time.Minute).Equal(signingInfo.JailedUntil))
+
+	// unjail only after jail period
+	err := keeper.Unjail(ctx, validator.ID)
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeType(types.CodeJailPeriodNotOver), err.Code())
+
+	require.Nil(t, keeper.Unjail(ctx.WithBlockTime(signingInfo.JailedUntil), validator.ID))
+
+	unjailed, _ := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.False(t, unjailed.Jailed)
+
+	err = keeper.Unjail(ctx, validator.ID)
+	require.NotNil(t, err)
+	require.Equal(t, sdk.CodeType(types.CodeValidatorNotJailed), err.Code())
+}
+
+func TestSignedBlocksWindowChange(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+	validator := cmn.GenRandomVal(1, 0, 10, uint64(10), false, 1)[0]
+	require.NoError(t, sk.AddValidator(ctx, validator))
+
+	// miss blocks at the end of 10 block window
+	height := int64(1)
+	for ; height <= 5; height++ {
+		keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), validator.Signer.Bytes(), true)
+	}
+	for ; height <= 9; height++ {
+		keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), validator.Signer.Bytes(), false)
+	}
+	require.Len(t, keeper.GetMissedBlocks(ctx, validator.ID), 4)
+
+	// shrinking window drops missed blocks beyond new window
+	params := keeper.GetParams(ctx)
+	params.SignedBlocksWindow = 5
+	keeper.SetParams(ctx, params)
+	keeper.HandleValidatorSignature(ctx.WithBlockHeight(height), validator.Signer.Bytes(), true)
+
+	signingInfo, found := keeper.GetSigningInfo(ctx, validator.ID)
+	require.True(t, found)
+	require.Equal(t, int64(5), signingInfo.SignedBlocksWindow)
+	require.Equal(t, height, signingInfo.StartHeight)
+	require.Equal(t, int64(1), signingInfo.IndexOffset)
+	require.Equal(t, int64(0), signingInfo.MissedBlocksCounter)
+	require.Empty(t, keeper.GetMissedBlocks(ctx, validator.ID))
+
+	jailed, _ := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.False(t, jailed.Jailed)
+}
+
+func TestHandleDoubleSign(t *testing.T) {
+	ctx, sk, keeper := createTestInput(t)
+	validator := cmn.GenRandomVal(1, 0, 10, uint64(10), false, 1)[0]
+	require.NoError(t, sk.AddValidator(ctx, validator))
+
+	// evidence older than max evidence age is ignored
+	evidence := abci.Evidence{
+		Type:      tmtypes.ABCIEvidenceTypeDuplicateVote,
+		Validator: abci.Validator{Address: validator.Signer.Bytes(), Power: 1000},
+		Height:    1,
+		Time:      ctx.BlockHeader().Time.Add(-2 * time.Minute),
+	}
+	keeper.HandleDoubleSign(ctx, evidence)
+	require.Empty(t, keeper.GetSlashRecords(ctx, validator.ID))
+
+	evidence.Time = ctx.BlockHeader().Time.Add(-time.Minute)
+	keeper.HandleDoubleSign(ctx, evidence)
+
+	jailed, _ := sk.GetValidatorFromValID(ctx, validator.ID)
+	require.True(t, jailed.Jailed)
+
+	// 5% of 10 tokens of recorded stake, not power reported in evidence
+	dividendAccount, err := sk.GetDividendAccountByID(ctx, hmTypes.DividendAccountID(validator.ID))
+	require.NoError(t, err)
+	require.Equal(t, "500000000000000000", dividendAccount.SlashedAmount)
+	require.Len(t, keeper.GetSlashRecords(ctx, validator.ID), 1)
+
+	// evidence is applied once
+	keeper.HandleDoubleSign(ctx, evidence)
+	require.Len(t, keeper.GetSlashRecords(ctx, validator.ID), 1)
+
+	// validator jailed for double sign can't be unjailed
+	unjailErr := keeper.Unjail(ctx.WithBlockTime(ctx.BlockHeader().Time.Add(time.Hour)), validator.ID)
+	require.NotNil(t, unjailErr)
+	require.Equal(t, sdk.CodeType(types.CodeValidatorTombstoned), unjailErr.Code())
+}
@@ -0,0 +1,85 @@
+package slashing
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/maticnetwork/heimdall/slashing/types"
+)
+
+// NewQuerier creates a querier for slashing REST endpoints
+func NewQuerier(keeper Keeper) sdk.Querier {
+	return func(ctx sdk.Context, path []string, req abci.RequestQuery) ([]byte, sdk.Error) {
+		switch path[0] {
+		case types.QueryParams:
+			return handleQueryParams(ctx, req, keeper)
+		case types.QuerySlashRecords:
+			return handleQuerySlashRecords(ctx, req, keeper)
+		case types.QuerySigningInfo:
+			return handleQuerySigningInfo(ctx, req, keeper)
+		case types.QuerySigningInfos:
+			return handleQuerySigningInfos(ctx, req, keeper)
+		default:
+			return nil, sdk.ErrUnknownRequest("unknown slashing query endpoint")
+		}
+	}
+}
+
+func handleQueryParams(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	bz, err := json.Marshal(keeper.GetParams(ctx))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySlashRecords(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	records := keeper.GetSlashRecords(ctx, params.ValidatorID)
+	if records == nil {
+		records = make([]types.SlashRecord, 0)
+	}
+
+	bz, err := json.Marshal(records)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySigningInfo(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrUnknownRequest(sdk.AppendMsgToErr("failed to parse params", err.Error()))
+	}
+
+	signingInfo, found := keeper.GetSigningInfo(ctx, params.ValidatorID)
+	if !found {
+		return nil, nil
+	}
+
+	bz, err := json.Marshal(signingInfo)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQuerySigningInfos(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	signingInfos := keeper.GetAllSigningInfos(ctx)
+	if signingInfos == nil {
+		signingInfos = make([]types.ValidatorSigningInfo, 0)
+	}
+
+	bz, err := json.Marshal(signingInfos)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,27 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Slashing errors reserve 6500 ~ 6599.
+const (
+	CodeValidatorNotJailed  sdk.CodeType = 6500
+	CodeValidatorTombstoned              = 6501
+	CodeJailPeriodNotOver                = 6502
+)
+
+// ErrValidatorNotJailed represents unjail of validator which is not jailed
+func ErrValidatorNotJailed(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorNotJailed, "Validator is not jailed")
+}
+
+// ErrValidatorTombstoned represents unjail of validator jailed for double sign
+func ErrValidatorTombstoned(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeValidatorTombstoned, "Validator is jailed for double sign and can't be unjailed")
+}
+
+// ErrJailPeriodNotOver represents unjail before jail period is over
+func ErrJailPeriodNotOver(codespace sdk.CodespaceType) sdk.Error {
+	return sdk.NewError(codespace, CodeJailPeriodNotOver, "Validator jail period is not over yet")
+}
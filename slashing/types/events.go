@@ -0,0 +1,20 @@
+package types
+
+// slashing module event types
+const (
+	EventTypeSlash    = "slash"
+	EventTypeLiveness = "liveness"
+	EventTypeJail     = "jail"
+	EventTypeUnjail   = "unjail"
+
+	AttributeKeyValidatorID     = "validator-id"
+	AttributeKeyValidatorSigner = "validator-signer"
+	AttributeKeyPower           = "power"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyReason          = "reason"
+	AttributeKeyHeight          = "height"
+	AttributeKeyMissedBlocks    = "missed-blocks"
+	AttributeKeyJailedUntil     = "jailed-until"
+
+	AttributeValueCategory = ModuleName
+)
@@ -0,0 +1,50 @@
+package types
+
+import (
+	"encoding/json"
+)
+
+// GenesisState is the slashing state that must be provided at genesis.
+type GenesisState struct {
+	Params       Params                  `json:"params" yaml:"params"`
+	SlashRecords []SlashRecord           `json:"slash_records" yaml:"slash_records"`
+	SigningInfos []ValidatorSigningInfo  `json:"signing_infos" yaml:"signing_infos"`
+	MissedBlocks []ValidatorMissedBlocks `json:"missed_blocks" yaml:"missed_blocks"`
+}
+
+// NewGenesisState creates a new genesis state.
+func NewGenesisState(
+	params Params,
+	slashRecords []SlashRecord,
+	signingInfos []ValidatorSigningInfo,
+	missedBlocks []ValidatorMissedBlocks,
+) GenesisState {
+	return GenesisState{
+		Params:       params,
+		SlashRecords: slashRecords,
+		SigningInfos: signingInfos,
+		MissedBlocks: missedBlocks,
+	}
+}
+
+// DefaultGenesisState returns a default genesis state
+func DefaultGenesisState() GenesisState {
+	return GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+// ValidateGenesis performs basic validation of slashing genesis data returning an
+// error for any failed validation criteria.
+func ValidateGenesis(data GenesisState) error {
+	return data.Params.Validate()
+}
+
+// GetGenesisStateFromAppState returns slashing GenesisState given raw application genesis state
+func GetGenesisStateFromAppState(appState map[string]json.RawMessage) GenesisState {
+	var genesisState GenesisState
+	if appState[ModuleName] != nil {
+		ModuleCdc.MustUnmarshalJSON(appState[ModuleName], &genesisState)
+	}
+	return genesisState
+}
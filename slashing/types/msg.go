@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	hmCommon "github.com/maticnetwork/heimdall/common"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var _ sdk.Msg = &MsgUnjail{}
+
+// MsgUnjail unjails validator jailed for downtime once jail period is over
+type MsgUnjail struct {
+	From hmTypes.HeimdallAddress `json:"from"`
+	ID   hmTypes.ValidatorID     `json:"id"`
+}
+
+// NewMsgUnjail creates new unjail msg
+func NewMsgUnjail(from hmTypes.HeimdallAddress, id uint64) MsgUnjail {
+	return MsgUnjail{
+		From: from,
+		ID:   hmTypes.NewValidatorID(id),
+	}
+}
+
+func (msg MsgUnjail) Type() string {
+	return "unjail"
+}
+
+func (msg MsgUnjail) Route() string {
+	return RouterKey
+}
+
+func (msg MsgUnjail) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgUnjail) GetSignBytes() []byte {
+	b, err := ModuleCdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgUnjail) ValidateBasic() sdk.Error {
+	if msg.ID == 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid from %v", msg.From.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,139 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/maticnetwork/heimdall/params/subspace"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Default parameter values
+const (
+	DefaultSignedBlocksWindow   int64         = 10000            // Number of blocks over which validator liveness is tracked, long enough to ride out node restarts and upgrades
+	DefaultDowntimeJailDuration time.Duration = 10 * time.Minute // Time validator jailed for downtime has to wait before unjail
+	DefaultMaxEvidenceAge       time.Duration = 2 * time.Minute  // Double sign evidence older than this is ignored
+)
+
+// Default parameter values
+var (
+	DefaultSlashFractionDoubleSign = hmTypes.NewDecWithPrec(5, 2) // 5% of stake is slashed for double sign
+	DefaultMinSignedPerWindow      = hmTypes.NewDecWithPrec(5, 2) // validator has to sign 5% of blocks in window
+)
+
+// Parameter keys
+var (
+	KeySlashFractionDoubleSign = []byte("SlashFractionDoubleSign")
+	KeySignedBlocksWindow      = []byte("SignedBlocksWindow")
+	KeyMinSignedPerWindow      = []byte("MinSignedPerWindow")
+	KeyDowntimeJailDuration    = []byte("DowntimeJailDuration")
+	KeyMaxEvidenceAge          = []byte("MaxEvidenceAge")
+)
+
+var _ subspace.ParamSet = &Params{}
+
+// Params defines the parameters for the slashing module.
+type Params struct {
+	SlashFractionDoubleSign hmTypes.Dec   `json:"slash_fraction_double_sign" yaml:"slash_fraction_double_sign"` // fraction of stake slashed for double sign
+	SignedBlocksWindow      int64         `json:"signed_blocks_window" yaml:"signed_blocks_window"`             // number of blocks over which liveness is tracked
+	MinSignedPerWindow      hmTypes.Dec   `json:"min_signed_per_window" yaml:"min_signed_per_window"`           // minimum fraction of blocks in window validator has to sign
+	DowntimeJailDuration    time.Duration `json:"downtime_jail_duration" yaml:"downtime_jail_duration"`         // time validator jailed for downtime stays jailed
+	MaxEvidenceAge          time.Duration `json:"max_evidence_age" yaml:"max_evidence_age"`                     // max age of double sign evidence which is punished
+}
+
+// NewParams creates a new Params object
+func NewParams(
+	slashFractionDoubleSign hmTypes.Dec,
+	signedBlocksWindow int64,
+	minSignedPerWindow hmTypes.Dec,
+	downtimeJailDuration time.Duration,
+	maxEvidenceAge time.Duration,
+) Params {
+	return Params{
+		SlashFractionDoubleSign: slashFractionDoubleSign,
+		SignedBlocksWindow:      signedBlocksWindow,
+		MinSignedPerWindow:      minSignedPerWindow,
+		DowntimeJailDuration:    downtimeJailDuration,
+		MaxEvidenceAge:          maxEvidenceAge,
+	}
+}
+
+// ParamKeyTable for slashing module
+func ParamKeyTable() subspace.KeyTable {
+	return subspace.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+// ParamSetPairs implements the ParamSet interface and returns all the key/value pairs
+// pairs of slashing module's parameters.
+// nolint
+func (p *Params) ParamSetPairs() subspace.ParamSetPairs {
+	return subspace.ParamSetPairs{
+		{KeySlashFractionDoubleSign, &p.SlashFractionDoubleSign},
+		{KeySignedBlocksWindow, &p.SignedBlocksWindow},
+		{KeyMinSignedPerWindow, &p.MinSignedPerWindow},
+		{KeyDowntimeJailDuration, &p.DowntimeJailDuration},
+		{KeyMaxEvidenceAge, &p.MaxEvidenceAge},
+	}
+}
+
+// Equal returns a boolean determining if two Params types are identical.
+func (p Params) Equal(p2 Params) bool {
+	bz1 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p)
+	bz2 := ModuleCdc.MustMarshalBinaryLengthPrefixed(&p2)
+	return bytes.Equal(bz1, bz2)
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return Params{
+		SlashFractionDoubleSign: DefaultSlashFractionDoubleSign,
+		SignedBlocksWindow:      DefaultSignedBlocksWindow,
+		MinSignedPerWindow:      DefaultMinSignedPerWindow,
+		DowntimeJailDuration:    DefaultDowntimeJailDuration,
+		MaxEvidenceAge:          DefaultMaxEvidenceAge,
+	}
+}
+
+// MinSignedBlocks returns minimum number of blocks validator has to sign in window
+func (p Params) MinSignedBlocks() int64 {
+	return p.MinSignedPerWindow.MulInt64(p.SignedBlocksWindow).RoundInt64()
+}
+
+// String implements the stringer interface.
+func (p Params) String() string {
+	var sb strings.Builder
+	sb.WriteString("Params: \n")
+	sb.WriteString(fmt.Sprintf("SlashFractionDoubleSign: %s\n", p.SlashFractionDoubleSign))
+	sb.WriteString(fmt.Sprintf("SignedBlocksWindow: %d\n", p.SignedBlocksWindow))
+	sb.WriteString(fmt.Sprintf("MinSignedPerWindow: %s\n", p.MinSignedPerWindow))
+	sb.WriteString(fmt.Sprintf("DowntimeJailDuration: %s\n", p.DowntimeJailDuration))
+	sb.WriteString(fmt.Sprintf("MaxEvidenceAge: %s\n", p.MaxEvidenceAge))
+	return sb.String()
+}
+
+// Validate checks that the parameters have valid values.
+func (p Params) Validate() error {
+	if p.SlashFractionDoubleSign.IsNil() || p.SlashFractionDoubleSign.IsNegative() || p.SlashFractionDoubleSign.GT(hmTypes.OneDec()) {
+		return fmt.Errorf("slash fraction for double sign must be between 0 and 1, is %s", p.SlashFractionDoubleSign)
+	}
+
+	if p.SignedBlocksWindow <= 0 {
+		return fmt.Errorf("signed blocks window must be positive, is %d", p.SignedBlocksWindow)
+	}
+
+	if p.MinSignedPerWindow.IsNil() || p.MinSignedPerWindow.IsNegative() || p.MinSignedPerWindow.GT(hmTypes.OneDec()) {
+		return fmt.Errorf("min signed per window must be between 0 and 1, is %s", p.MinSignedPerWindow)
+	}
+
+	if p.DowntimeJailDuration <= 0 {
+		return fmt.Errorf("downtime jail duration must be positive, is %s", p.DowntimeJailDuration)
+	}
+
+	if p.MaxEvidenceAge <= 0 {
+		return fmt.Errorf("max evidence age must be positive, is %s", p.MaxEvidenceAge)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	newParams := func(slashFraction hmTypes.Dec) Params {
+		return NewParams(slashFraction, DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration, DefaultMaxEvidenceAge)
+	}
+
+	require.NoError(t, DefaultParams().Validate())
+	require.NoError(t, newParams(hmTypes.ZeroDec()).Validate())
+	require.NoError(t, newParams(hmTypes.OneDec()).Validate())
+
+	require.Error(t, newParams(hmTypes.NewDecWithPrec(-1, 2)).Validate())
+	require.Error(t, newParams(hmTypes.NewDecWithPrec(101, 2)).Validate())
+	require.Error(t, Params{}.Validate())
+
+	require.Error(t, NewParams(DefaultSlashFractionDoubleSign, 0, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration, DefaultMaxEvidenceAge).Validate())
+	require.Error(t, NewParams(DefaultSlashFractionDoubleSign, DefaultSignedBlocksWindow, hmTypes.NewDecWithPrec(11, 1), DefaultDowntimeJailDuration, DefaultMaxEvidenceAge).Validate())
+	require.Error(t, NewParams(DefaultSlashFractionDoubleSign, DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, 0, DefaultMaxEvidenceAge).Validate())
+	require.Error(t, NewParams(DefaultSlashFractionDoubleSign, DefaultSignedBlocksWindow, DefaultMinSignedPerWindow, DefaultDowntimeJailDuration, 0).Validate())
+}
+
+func TestMinSignedBlocks(t *testing.T) {
+	require.Equal(t, int64(500), DefaultParams().MinSignedBlocks())
+	require.Equal(t, int64(0), NewParams(DefaultSlashFractionDoubleSign, 10, hmTypes.ZeroDec(), time.Minute, time.Minute).MinSignedBlocks())
+	require.Equal(t, int64(10), NewParams(DefaultSlashFractionDoubleSign, 10, hmTypes.OneDec(), time.Minute, time.Minute).MinSignedBlocks())
+}
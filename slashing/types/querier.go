@@ -0,0 +1,23 @@
+package types
+
+import (
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// query endpoints supported by the slashing Querier
+const (
+	QueryParams       = "params"
+	QuerySlashRecords = "slash-records"
+	QuerySigningInfo  = "signing-info"
+	QuerySigningInfos = "signing-infos"
+)
+
+// QueryValidatorParams defines the params for querying slashing info of validator
+type QueryValidatorParams struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id"`
+}
+
+// NewQueryValidatorParams creates a new instance of QueryValidatorParams.
+func NewQueryValidatorParams(validatorID hmTypes.ValidatorID) QueryValidatorParams {
+	return QueryValidatorParams{ValidatorID: validatorID}
+}
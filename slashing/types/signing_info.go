@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorSigningInfo liveness information of validator
+type ValidatorSigningInfo struct {
+	ValidatorID         hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	SignedBlocksWindow  int64               `json:"signed_blocks_window" yaml:"signed_blocks_window"`   // signed blocks window size missed blocks are tracked for
+	StartHeight         int64               `json:"start_height" yaml:"start_height"`                   // height at which liveness tracking started
+	IndexOffset         int64               `json:"index_offset" yaml:"index_offset"`                   // index offset into signed blocks window
+	JailedUntil         time.Time           `json:"jailed_until" yaml:"jailed_until"`                   // time until which validator is jailed for downtime
+	Tombstoned          bool                `json:"tombstoned" yaml:"tombstoned"`                       // whether validator is jailed for double sign, can't be unjailed
+	MissedBlocksCounter int64               `json:"missed_blocks_counter" yaml:"missed_blocks_counter"` // missed blocks in current window
+}
+
+// NewValidatorSigningInfo creates new validator signing info
+func NewValidatorSigningInfo(validatorID hmTypes.ValidatorID, signedBlocksWindow int64, startHeight int64) ValidatorSigningInfo {
+	return ValidatorSigningInfo{
+		ValidatorID:        validatorID,
+		SignedBlocksWindow: signedBlocksWindow,
+		StartHeight:        startHeight,
+	}
+}
+
+// String returns human readable signing info
+func (i ValidatorSigningInfo) String() string {
+	return fmt.Sprintf(
+		"ValidatorSigningInfo{%v %v %v %v %v %v %v}",
+		i.ValidatorID,
+		i.SignedBlocksWindow,
+		i.StartHeight,
+		i.IndexOffset,
+		i.JailedUntil,
+		i.Tombstoned,
+		i.MissedBlocksCounter,
+	)
+}
+
+// ValidatorMissedBlocks missed block indexes of validator in current window
+type ValidatorMissedBlocks struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	Indexes     []int64             `json:"indexes" yaml:"indexes"`
+}
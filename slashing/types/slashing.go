@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// slashing reasons
+const (
+	SlashReasonDoubleSign = "double_sign"
+	JailReasonDowntime    = "missing_signature"
+)
+
+// SlashRecord record of validator slashed for misbehaviour at given height
+type SlashRecord struct {
+	ValidatorID hmTypes.ValidatorID `json:"validator_id" yaml:"validator_id"`
+	Reason      string              `json:"reason" yaml:"reason"`
+	Height      int64               `json:"height" yaml:"height"` // height of infraction
+	Power       int64               `json:"power" yaml:"power"`   // power of validator at infraction
+	Amount      string              `json:"amount" yaml:"amount"` // string representation of slashed big.Int amount
+}
+
+// NewSlashRecord creates new slash record
+func NewSlashRecord(validatorID hmTypes.ValidatorID, reason string, height int64, power int64, amount string) SlashRecord {
+	return SlashRecord{
+		ValidatorID: validatorID,
+		Reason:      reason,
+		Height:      height,
+		Power:       power,
+		Amount:      amount,
+	}
+}
+
+// String returns human readable slash record
+func (r SlashRecord) String() string {
+	return fmt.Sprintf(
+		"SlashRecord{%v %v %v %v %v}",
+		r.ValidatorID,
+		r.Reason,
+		r.Height,
+		r.Power,
+		r.Amount,
+	)
+}
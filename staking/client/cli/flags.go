@@ -1,18 +1,24 @@
 package cli
 
 const (
-	FlagProposerAddress  = "proposer"
-	FlagValidatorAddress = "validator"
-	FlagValidatorID      = "id"
-	FlagSignerAddress    = "signer"
-	FlagSignerPubkey     = "signer-pubkey"
-	FlagNewSignerPubkey  = "new-pubkey"
-	FlagAmount           = "staked-amount"
-	FlagAcceptDelegation = "accept-delegation"
-	FlagTxHash           = "tx-hash"
-	FlagLogIndex         = "log-index"
-	FlagFeeAmount        = "fee-amount"
+	FlagProposerAddress   = "proposer"
+	FlagValidatorAddress  = "validator"
+	FlagDelegatorAddress  = "delegator"
+	FlagValidatorID       = "id"
+	FlagSignerAddress     = "signer"
+	FlagSignerPubkey      = "signer-pubkey"
+	FlagNewSignerPubkey   = "new-pubkey"
+	FlagAmount            = "staked-amount"
+	FlagAcceptDelegation  = "accept-delegation"
+	FlagTxHash            = "tx-hash"
+	FlagLogIndex          = "log-index"
+	FlagBlockNumber       = "block-number"
+	FlagDeactivationEpoch = "deactivation-epoch"
+	FlagFeeAmount         = "fee-amount"
 
 	FlagStartEpoch = "start-epoch"
 	FlagEndEpoch   = "end-epoch"
+
+	FlagSnapshotHeight   = "at-height"
+	FlagCheckpointNumber = "checkpoint"
 )
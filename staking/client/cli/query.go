@@ -31,6 +31,8 @@ func GetQueryCmd(cdc *codec.Codec) *cobra.Command {
 		client.GetCommands(
 			GetValidatorInfo(cdc),
 			GetCurrentValSet(cdc),
+			GetDelegations(cdc),
+			GetHistoricalValSet(cdc),
 		)...,
 	)
 
@@ -83,6 +85,92 @@ func GetValidatorInfo(cdc *codec.Codec) *cobra.Command {
 	return cmd
 }
 
+// GetDelegations delegations of delegator or delegations on validator
+func GetDelegations(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "delegations",
+		Short: "show delegations via delegator address or validator id",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			validatorID := viper.GetInt64(FlagValidatorID)
+			delegatorStr := viper.GetString(FlagDelegatorAddress)
+			if validatorID == 0 && delegatorStr == "" {
+				return fmt.Errorf("delegator address or validator ID required")
+			}
+
+			var queryParams []byte
+			var err error
+			var t string
+			if delegatorStr != "" {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryDelegatorParams(hmTypes.HexToHeimdallAddress(delegatorStr)))
+				t = types.QueryDelegatorDelegations
+			} else {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(validatorID)))
+				t = types.QueryValidatorDelegations
+			}
+			if err != nil {
+				return err
+			}
+
+			// get delegations
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, t), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator ID here>")
+	cmd.Flags().String(FlagDelegatorAddress, "", "--delegator=<delegator address here>")
+	return cmd
+}
+
+// GetHistoricalValSet validator set at height or validator set which signed checkpoint
+func GetHistoricalValSet(cdc *codec.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "historical-validator-set",
+		Short: "show validator set at height or of checkpoint",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx := context.NewCLIContext().WithCodec(cdc)
+			height := viper.GetInt64(FlagSnapshotHeight)
+			checkpointNumber := viper.GetUint64(FlagCheckpointNumber)
+			if height == 0 && checkpointNumber == 0 {
+				return fmt.Errorf("height or checkpoint number required")
+			}
+
+			var queryParams []byte
+			var err error
+			var t string
+			if checkpointNumber != 0 {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(checkpointNumber))
+				t = types.QueryCheckpointValSet
+			} else {
+				queryParams, err = cliCtx.Codec.MarshalJSON(types.NewQueryHeightParams(height))
+				t = types.QueryValidatorSetAtHeight
+			}
+			if err != nil {
+				return err
+			}
+
+			// get validator set snapshot
+			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, t), queryParams)
+			if err != nil {
+				return err
+			}
+
+			fmt.Println(string(res))
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(FlagSnapshotHeight, 0, "--at-height=<block height here>")
+	cmd.Flags().Uint64(FlagCheckpointNumber, 0, "--checkpoint=<checkpoint number here>")
+	return cmd
+}
+
 // GetCurrentValSet validator information via address
 func GetCurrentValSet(cdc *codec.Codec) *cobra.Command {
 	cmd := &cobra.Command{
@@ -5,7 +5,7 @@ import (
 	"encoding/hex"
 	"errors"
 	"fmt"
-	"time"
+	"math/big"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -79,7 +79,7 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 			}
 
 			// get main tx receipt
-			receipt, err := contractCallerObj.GetConfirmedTxReceipt(time.Now().UTC(), hmTypes.HexToHeimdallHash(txhash).EthHash())
+			receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(txhash).EthHash())
 			if err != nil || receipt == nil {
 				return errors.New("Transaction is not confirmed yet. Please for sometime and try again")
 			}
@@ -115,6 +115,8 @@ func SendValidatorJoinTx(cdc *codec.Codec) *cobra.Command {
 			msg := types.NewMsgValidatorJoin(
 				proposer,
 				event.ValidatorId.Uint64(),
+				event.ActivationEpoch.Uint64(),
+				hmTypes.NewIntFromBigInt(event.Amount),
 				pubkey,
 				hmTypes.HexToHeimdallHash(txhash),
 				uint64(logIndex),
@@ -161,6 +163,7 @@ func SendValidatorExitTx(cdc *codec.Codec) *cobra.Command {
 			msg := types.NewMsgValidatorExit(
 				proposer,
 				uint64(validator),
+				uint64(viper.GetInt64(FlagDeactivationEpoch)),
 				hmTypes.HexToHeimdallHash(txhash),
 				uint64(viper.GetInt64(FlagLogIndex)),
 			)
@@ -174,9 +177,11 @@ func SendValidatorExitTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator ID here>")
 	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
 	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagDeactivationEpoch, "", "--deactivation-epoch=<deactivation-epoch>")
 	cmd.MarkFlagRequired(FlagValidatorID)
 	cmd.MarkFlagRequired(FlagTxHash)
 	cmd.MarkFlagRequired(FlagLogIndex)
+	cmd.MarkFlagRequired(FlagDeactivationEpoch)
 
 	return cmd
 }
@@ -222,6 +227,7 @@ func SendValidatorUpdateTx(cdc *codec.Codec) *cobra.Command {
 				pubkey,
 				hmTypes.HexToHeimdallHash(txhash),
 				uint64(viper.GetInt64(FlagLogIndex)),
+				uint64(viper.GetInt64(FlagBlockNumber)),
 			)
 
 			// broadcast messages
@@ -234,9 +240,11 @@ func SendValidatorUpdateTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().String(FlagNewSignerPubkey, "", "--new-pubkey=<new-signer-pubkey>")
 	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
 	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagBlockNumber, "", "--block-number=<block-number>")
 	cmd.MarkFlagRequired(FlagTxHash)
 	cmd.MarkFlagRequired(FlagNewSignerPubkey)
 	cmd.MarkFlagRequired(FlagLogIndex)
+	cmd.MarkFlagRequired(FlagBlockNumber)
 
 	return cmd
 }
@@ -265,11 +273,18 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			amount, ok := big.NewInt(0).SetString(viper.GetString(FlagAmount), 10)
+			if !ok {
+				return fmt.Errorf("Invalid new stake amount")
+			}
+
 			msg := types.NewMsgStakeUpdate(
 				proposer,
 				uint64(validator),
+				hmTypes.NewIntFromBigInt(amount),
 				hmTypes.HexToHeimdallHash(txhash),
 				uint64(viper.GetInt64(FlagLogIndex)),
+				uint64(viper.GetInt64(FlagBlockNumber)),
 			)
 
 			// broadcast messages
@@ -281,8 +296,12 @@ func SendValidatorStakeUpdateTx(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().Int(FlagValidatorID, 0, "--id=<validator-id>")
 	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
 	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagAmount, "", "--staked-amount=<staked-amount>")
+	cmd.Flags().String(FlagBlockNumber, "", "--block-number=<block-number>")
 	cmd.MarkFlagRequired(FlagTxHash)
 	cmd.MarkFlagRequired(FlagLogIndex)
+	cmd.MarkFlagRequired(FlagAmount)
+	cmd.MarkFlagRequired(FlagBlockNumber)
 
 	return cmd
 }
@@ -4,7 +4,6 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"math/big"
 	"net/http"
 
 	"github.com/cosmos/cosmos-sdk/client/context"
@@ -30,10 +29,22 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/validator/{id}",
 		validatorByIDHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator/{id}/delegations",
+		validatorDelegationsHandlerFn(cliCtx),
+	).Methods("GET")
+	r.HandleFunc(
+		"/staking/delegations/{address}",
+		delegatorDelegationsHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/validator-set",
 		validatorSetHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/validator-set/checkpoint/{number}",
+		checkpointValidatorSetHandlerFn(cliCtx),
+	).Methods("GET")
 	r.HandleFunc(
 		"/staking/proposer/{times}",
 		proposerHandlerFn(cliCtx),
@@ -42,10 +53,6 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/current-proposer",
 		currentProposerHandlerFn(cliCtx),
 	).Methods("GET")
-	r.HandleFunc(
-		"/staking/slash-validator",
-		slashValidatorHandlerFn(cliCtx),
-	).Methods("GET")
 	r.HandleFunc(
 		"/staking/dividend-account/{id}",
 		dividendAccountByIDHandlerFn(cliCtx),
@@ -66,6 +73,10 @@ func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
 		"/staking/account-proof/verify/",
 		VerifyAccountProofHandlerFn(cliCtx),
 	).Methods("GET")
+	r.HandleFunc(
+		"/staking/sequence",
+		stakingSequenceHandlerFn(cliCtx),
+	).Methods("GET")
 }
 
 // Returns validator information by signer address
@@ -193,23 +204,33 @@ func validatorByIDHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-// get current validator set
-func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+// Returns delegations of all delegators on validator by val ID
+func validatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentValidatorSet), nil)
+		// get id
+		id, ok := rest.ParseUint64OrReturnBadRequest(w, vars["id"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryValidatorParams(hmTypes.ValidatorID(id)))
 		if err != nil {
-			RestLogger.Error("Error while fetching current validator set ", "Error", err.Error())
-			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		// error if no checkpoint found
-		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found"); !ok {
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorDelegations), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching validator delegations", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
@@ -219,38 +240,81 @@ func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-// get proposer for current validator set
-func proposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+// Returns delegations of delegator on all validators
+func delegatorDelegationsHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
 		vars := mux.Vars(r)
+		delegator := hmTypes.HexToHeimdallAddress(vars["address"])
 
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		// get proposer times
-		times, ok := rest.ParseUint64OrReturnBadRequest(w, vars["times"])
-		if !ok {
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryDelegatorParams(delegator))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		// get query params
-		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerParams(times))
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryDelegatorDelegations), queryParams)
 		if err != nil {
-			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			RestLogger.Error("Error while fetching delegations", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposer), queryParams)
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// get current validator set, or validator set at given height from snapshots
+func validatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		// height refers to snapshot, validator set is queried from latest state
+		if heightStr := r.FormValue("height"); heightStr != "" {
+			snapshotHeight, ok := rest.ParseInt64OrReturnBadRequest(w, heightStr)
+			if !ok {
+				return
+			}
+
+			// get query params
+			queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryHeightParams(snapshotHeight))
+			if err != nil {
+				hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+				return
+			}
+
+			res, height, err := cliCtx.WithHeight(0).QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryValidatorSetAtHeight), queryParams)
+			if err != nil {
+				RestLogger.Error("Error while fetching validator set at height", "Error", err.Error())
+				hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+				return
+			}
+
+			// return result
+			cliCtx = cliCtx.WithHeight(height)
+			rest.PostProcessResponse(w, cliCtx, res)
+			return
+		}
+
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentValidatorSet), nil)
 		if err != nil {
-			RestLogger.Error("Error while fetching proposers ", "Error", err.Error())
+			RestLogger.Error("Error while fetching current validator set ", "Error", err.Error())
 			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
 		// error if no checkpoint found
-		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proposer found"); !ok {
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No checkpoint found"); !ok {
 			return
 		}
 
@@ -260,23 +324,33 @@ func proposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-// currentProposerHandlerFn get proposer for current validator set
-func currentProposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+// get validator set which signed checkpoint
+func checkpointValidatorSetHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
+
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentProposer), nil)
+		// get checkpoint number
+		number, ok := rest.ParseUint64OrReturnBadRequest(w, vars["number"])
+		if !ok {
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryCheckpointParams(number))
 		if err != nil {
-			RestLogger.Error("Error while fetching current proposer ", "Error", err.Error())
-			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		// error if no checkpoint found
-		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proposer found"); !ok {
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCheckpointValSet), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching checkpoint validator set", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
@@ -286,48 +360,69 @@ func currentProposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	}
 }
 
-func slashValidatorHandlerFn(
-	cliCtx context.CLIContext,
-) http.HandlerFunc {
+// get proposer for current validator set
+func proposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
+		vars := mux.Vars(r)
 
 		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
 		if !ok {
 			return
 		}
 
-		params := r.URL.Query()
-		valID, ok := rest.ParseUint64OrReturnBadRequest(w, params.Get("val_id"))
+		// get proposer times
+		times, ok := rest.ParseUint64OrReturnBadRequest(w, vars["times"])
 		if !ok {
 			return
 		}
 
-		slashAmount, ok := big.NewInt(0).SetString(params.Get("slash_amount"), 10)
-		if !ok {
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryProposerParams(times))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
 			return
 		}
 
-		RestLogger.Info("Slashing validator - ", "valID", valID, "slashAmount", slashAmount)
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryProposer), queryParams)
+		if err != nil {
+			RestLogger.Error("Error while fetching proposers ", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			return
+		}
 
-		slashingParams := types.NewValidatorSlashParams(hmTypes.ValidatorID(valID), slashAmount)
+		// error if no checkpoint found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proposer found"); !ok {
+			return
+		}
 
-		bz, err := cliCtx.Codec.MarshalJSON(slashingParams)
-		if err != nil {
-			RestLogger.Info("Error marshallingSlashing Params - ", "err", err)
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
+
+// currentProposerHandlerFn get proposer for current validator set
+func currentProposerHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
 			return
 		}
 
-		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QuerySlashValidator), bz)
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryCurrentProposer), nil)
 		if err != nil {
-			RestLogger.Info("Error query data - ", "err", err)
-			rest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
+			RestLogger.Error("Error while fetching current proposer ", "Error", err.Error())
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, err.Error())
 			return
 		}
 
-		RestLogger.Info("Slashed validator successfully ", "res", res)
+		// error if no checkpoint found
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No proposer found"); !ok {
+			return
+		}
 
-		cliCtx.WithHeight(height)
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
 		rest.PostProcessResponse(w, cliCtx, res)
 	}
 }
@@ -545,3 +640,47 @@ func VerifyAccountProofHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 
 	}
 }
+
+// Returns staking sequence if staking tx (tx hash and log index) is already processed
+func stakingSequenceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := r.URL.Query()
+		logIndex, ok := rest.ParseUint64OrReturnBadRequest(w, params.Get("log_index"))
+		if !ok {
+			return
+		}
+
+		txHash := params.Get("tx_hash")
+		if txHash == "" {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "tx_hash is required")
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(types.NewQueryStakingSequenceParams(txHash, logIndex))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, types.QueryStakingSequence), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// tx is not processed yet
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No staking sequence found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
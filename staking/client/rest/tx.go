@@ -28,10 +28,12 @@ type (
 	AddValidatorReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		ID           uint64         `json:"ID"`
-		SignerPubKey hmTypes.PubKey `json:"pubKey"`
-		TxHash       string         `json:"tx_hash"`
-		LogIndex     uint64         `json:"log_index"`
+		ID              uint64         `json:"ID"`
+		ActivationEpoch uint64         `json:"activation_epoch"`
+		Amount          hmTypes.Int    `json:"amount"`
+		SignerPubKey    hmTypes.PubKey `json:"pubKey"`
+		TxHash          string         `json:"tx_hash"`
+		LogIndex        uint64         `json:"log_index"`
 	}
 
 	// UpdateSignerReq update validator signer request object
@@ -42,24 +44,28 @@ type (
 		NewSignerPubKey hmTypes.PubKey `json:"pubKey"`
 		TxHash          string         `json:"tx_hash"`
 		LogIndex        uint64         `json:"log_index"`
+		BlockNumber     uint64         `json:"block_number"`
 	}
 
 	// UpdateValidatorStakeReq update validator stake request object
 	UpdateValidatorStakeReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		ID       uint64 `json:"ID"`
-		TxHash   string `json:"tx_hash"`
-		LogIndex uint64 `json:"log_index"`
+		ID          uint64      `json:"ID"`
+		NewAmount   hmTypes.Int `json:"amount"`
+		TxHash      string      `json:"tx_hash"`
+		LogIndex    uint64      `json:"log_index"`
+		BlockNumber uint64      `json:"block_number"`
 	}
 
 	// RemoveValidatorReq remove validator request object
 	RemoveValidatorReq struct {
 		BaseReq rest.BaseReq `json:"base_req"`
 
-		ID       uint64 `json:"ID"`
-		TxHash   string `json:"tx_hash"`
-		LogIndex uint64 `json:"log_index"`
+		ID                uint64 `json:"ID"`
+		DeactivationEpoch uint64 `json:"deactivation_epoch"`
+		TxHash            string `json:"tx_hash"`
+		LogIndex          uint64 `json:"log_index"`
 	}
 )
 
@@ -80,6 +86,8 @@ func newValidatorJoinHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		msg := types.NewMsgValidatorJoin(
 			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
 			req.ID,
+			req.ActivationEpoch,
+			req.Amount,
 			req.SignerPubKey,
 			hmTypes.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
@@ -107,6 +115,7 @@ func newValidatorExitHandler(cliCtx context.CLIContext) http.HandlerFunc {
 		msg := types.NewMsgValidatorExit(
 			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
 			req.ID,
+			req.DeactivationEpoch,
 			hmTypes.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
 		)
@@ -136,6 +145,7 @@ func newValidatorUpdateHandler(cliCtx context.CLIContext) http.HandlerFunc {
 			req.NewSignerPubKey,
 			hmTypes.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
+			req.BlockNumber,
 		)
 
 		// send response
@@ -160,8 +170,10 @@ func newValidatorStakeUpdateHandler(cliCtx context.CLIContext) http.HandlerFunc
 		msg := types.NewMsgStakeUpdate(
 			hmTypes.HexToHeimdallAddress(req.BaseReq.From),
 			req.ID,
+			req.NewAmount,
 			hmTypes.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
+			req.BlockNumber,
 		)
 
 		// send response
@@ -45,6 +45,12 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 	for _, sequence := range data.StakingSequences {
 		keeper.SetStakingSequence(ctx, sequence)
 	}
+	for _, delegation := range data.Delegations {
+		keeper.SetDelegation(ctx, delegation)
+	}
+
+	// snapshot of genesis validator set
+	keeper.SetValidatorSetSnapshot(ctx)
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper.
@@ -55,5 +61,6 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) types.GenesisState {
 		keeper.GetValidatorSet(ctx),
 		keeper.GetAllDividendAccounts(ctx),
 		keeper.GetStakingSequences(ctx),
+		keeper.GetAllDelegations(ctx),
 	)
 }
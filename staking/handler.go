@@ -15,20 +15,29 @@ import (
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
-// NewHandler new handler
-func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
+// NewHandler new handler. Msgs validated against mainchain reach it through sidechannel,
+// once validators voted for them.
+func NewHandler(k Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 		switch msg := msg.(type) {
 		case types.MsgValidatorJoin:
-			return HandleMsgValidatorJoin(ctx, msg, k, contractCaller)
+			return HandleMsgValidatorJoin(ctx, msg, k)
 		case types.MsgValidatorExit:
-			return HandleMsgValidatorExit(ctx, msg, k, contractCaller)
+			return HandleMsgValidatorExit(ctx, msg, k)
 		case types.MsgSignerUpdate:
-			return HandleMsgSignerUpdate(ctx, msg, k, contractCaller)
+			return HandleMsgSignerUpdate(ctx, msg, k)
 		case types.MsgStakeUpdate:
-			return HandleMsgStakeUpdate(ctx, msg, k, contractCaller)
+			return HandleMsgStakeUpdate(ctx, msg, k)
+		case types.MsgValidatorReStake:
+			return HandleMsgValidatorReStake(ctx, msg, k)
+		case types.MsgValidatorJailed:
+			return HandleMsgValidatorJailed(ctx, msg, k)
+		case types.MsgShareMint:
+			return HandleMsgShareMint(ctx, msg, k)
+		case types.MsgShareBurn:
+			return HandleMsgShareBurn(ctx, msg, k)
 		default:
 			return sdk.ErrTxDecode("Invalid message in checkpoint module").Result()
 		}
@@ -36,41 +45,13 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 }
 
 // HandleMsgValidatorJoin msg validator join
-func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keeper) sdk.Result {
 	k.Logger(ctx).Info("Handling new validator join", "msg", msg)
 
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
-	}
-
-	// decode validator join event
-	eventLog, err := contractCaller.DecodeValidatorJoinEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch logs for txHash").Result()
-	}
-
 	// Generate PubKey from Pubkey in message and signer
 	pubkey := msg.SignerPubKey
 	signer := pubkey.Address()
 
-	// check signer in message corresponds
-	if !bytes.Equal(signer.Bytes(), eventLog.Signer.Bytes()) {
-		k.Logger(ctx).Error(
-			"Signer Address does not match",
-			"msgValidator", signer.String(),
-			"mainchainValidator", eventLog.Signer.Hex(),
-		)
-		return hmCommon.ErrValSignerMismatch(k.Codespace()).Result()
-	}
-
-	// check msg id
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "ID in message doesn't match with id in log. msgId %v validatorIdFromTx %v", msg.ID, eventLog.ValidatorId).Result()
-	}
-
 	// Check if validator has been validator before
 	if _, ok := k.GetSignerFromValidatorID(ctx, msg.ID); ok {
 		k.Logger(ctx).Error("Validator has been validator before, cannot join with same ID", "validatorId", msg.ID)
@@ -84,15 +65,15 @@ func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keepe
 	}
 
 	// get voting power from amount
-	votingPower, err := helper.GetPowerFromAmount(eventLog.Amount)
+	votingPower, err := helper.GetPowerFromAmount(msg.Amount.BigInt())
 	if err != nil {
-		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", eventLog.Amount, msg.ID)).Result()
+		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", msg.Amount, msg.ID)).Result()
 	}
 
 	// create new validator
 	newValidator := hmTypes.Validator{
 		ID:          msg.ID,
-		StartEpoch:  eventLog.ActivationEpoch.Uint64(),
+		StartEpoch:  msg.ActivationEpoch,
 		EndEpoch:    0,
 		VotingPower: votingPower.Int64(),
 		PubKey:      pubkey,
@@ -123,26 +104,9 @@ func HandleMsgValidatorJoin(ctx sdk.Context, msg types.MsgValidatorJoin, k Keepe
 }
 
 // HandleMsgStakeUpdate handles stake update message
-func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Handling stake update", "Validator", msg.ID)
 
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
-	}
-
-	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch logs for txHash").Result()
-	}
-
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "ID in message doesn't match with id in log. msgId %v validatorIdFromTx %v", msg.ID, eventLog.ValidatorId).Result()
-	}
-
 	// pull validator from store
 	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
 	if !ok {
@@ -152,7 +116,7 @@ func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, c
 
 	// sequence id
 
-	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(msg.BlockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
 	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
 
 	// check if incoming tx is older
@@ -165,9 +129,9 @@ func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, c
 	validator.LastUpdated = sequence.String()
 
 	// set validator amount
-	p, err := helper.GetPowerFromAmount(eventLog.NewAmount)
+	p, err := helper.GetPowerFromAmount(msg.NewAmount.BigInt())
 	if err != nil {
-		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", eventLog.NewAmount, msg.ID)).Result()
+		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", msg.NewAmount, msg.ID)).Result()
 	}
 	validator.VotingPower = p.Int64()
 
@@ -197,34 +161,12 @@ func HandleMsgStakeUpdate(ctx sdk.Context, msg types.MsgStakeUpdate, k Keeper, c
 }
 
 // HandleMsgSignerUpdate handles signer update message
-func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper) sdk.Result {
 	k.Logger(ctx).Debug("Handling signer update", "Validator", msg.ID, "Signer", msg.NewSignerPubKey.Address())
 
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
-	}
-
 	newPubKey := msg.NewSignerPubKey
 	newSigner := newPubKey.Address()
 
-	eventLog, err := contractCaller.DecodeSignerUpdateEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch signer update log for txHash").Result()
-	}
-
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "ID in message doesn't match with id in log. msgId %v validatorIdFromTx %v", msg.ID, eventLog.ValidatorId).Result()
-	}
-
-	if bytes.Compare(eventLog.NewSigner.Bytes(), newSigner.Bytes()) != 0 {
-		k.Logger(ctx).Error("Signer in txhash and msg dont match", "msgSigner", newSigner.String(), "signerTx", eventLog.NewSigner.String())
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Signer in txhash and msg dont match").Result()
-	}
-
 	// pull validator from store
 	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
 	if !ok {
@@ -235,7 +177,7 @@ func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper,
 
 	// sequence id
 
-	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(msg.BlockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
 	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
 
 	// check if incoming tx is older
@@ -275,8 +217,7 @@ func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper,
 	k.Logger(ctx).Debug("Adding new validator", "validator", validator.String())
 
 	// save validator
-	err = k.AddValidator(ctx, validator)
-	if err != nil {
+	if err := k.AddValidator(ctx, validator); err != nil {
 		k.Logger(ctx).Error("Unable to update signer", "error", err, "ValidatorID", validator.ID)
 		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
 	}
@@ -314,27 +255,9 @@ func HandleMsgSignerUpdate(ctx sdk.Context, msg types.MsgSignerUpdate, k Keeper,
 }
 
 // HandleMsgValidatorExit handle msg validator exit
-func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper, contractCaller helper.IContractCaller) sdk.Result {
+func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keeper) sdk.Result {
 	k.Logger(ctx).Info("Handling validator exit", "ValidatorID", msg.ID)
 
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
-	}
-
-	// decode validator exit
-	eventLog, err := contractCaller.DecodeValidatorExitEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch unstake log for txHash").Result()
-	}
-
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesn't match with id in log", "msgId", msg.ID, "validatorIdFromTx", eventLog.ValidatorId)
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "ID in message doesn't match with id in log. msgId %v validatorIdFromTx %v", msg.ID, eventLog.ValidatorId).Result()
-	}
-
 	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
 	if !ok {
 		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
@@ -349,7 +272,7 @@ func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keepe
 	}
 
 	// set end epoch
-	validator.EndEpoch = eventLog.DeactivationEpoch.Uint64()
+	validator.EndEpoch = msg.DeactivationEpoch
 
 	// Add deactivation time for validator
 	if err := k.AddValidator(ctx, validator); err != nil {
@@ -369,3 +292,207 @@ func HandleMsgValidatorExit(ctx sdk.Context, msg types.MsgValidatorExit, k Keepe
 		Events: ctx.EventManager().Events(),
 	}
 }
+
+// HandleMsgValidatorReStake handles validator restake, updating voting power to new total stake
+func HandleMsgValidatorReStake(ctx sdk.Context, msg types.MsgValidatorReStake, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling validator restake", "Validator", msg.ID)
+
+	// pull validator from store
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorId", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	// sequence id
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(msg.BlockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	// update last updated
+	validator.LastUpdated = sequence.String()
+
+	// set voting power from total stake after restake
+	p, err := helper.GetPowerFromAmount(msg.Total.BigInt())
+	if err != nil {
+		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Invalid amount %v for validator %v", msg.Total, msg.ID)).Result()
+	}
+	validator.VotingPower = p.Int64()
+
+	// save validator
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Unable to update validator", "error", err, "ValidatorID", validator.ID)
+		return hmCommon.ErrSignerUpdateError(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeReStake,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, strconv.FormatUint(validator.ID.Uint64(), 10)),
+			sdk.NewAttribute(types.AttributeKeyVotingPower, strconv.FormatInt(validator.VotingPower, 10)),
+			sdk.NewAttribute(types.AttributeKeyUpdatedAt, validator.LastUpdated),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// HandleMsgValidatorJailed handles validator jailed on mainchain, setting its end epoch to exit epoch
+// so it is removed from validator set like an exiting validator
+func HandleMsgValidatorJailed(ctx sdk.Context, msg types.MsgValidatorJailed, k Keeper) sdk.Result {
+	k.Logger(ctx).Info("Handling validator jailed", "ValidatorID", msg.ID)
+
+	validator, ok := k.GetValidatorFromValID(ctx, msg.ID)
+	if !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	// sequence id
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(msg.BlockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	// exit epoch of jail takes precedence over later deactivation epoch
+	exitEpoch := msg.ExitEpoch
+	if validator.EndEpoch == 0 || exitEpoch < validator.EndEpoch {
+		validator.EndEpoch = exitEpoch
+	}
+	validator.LastUpdated = sequence.String()
+
+	if err := k.AddValidator(ctx, validator); err != nil {
+		k.Logger(ctx).Error("Error while setting exit epoch to jailed validator", "error", err, "validatorID", validator.ID.String())
+		return hmCommon.ErrValidatorNotDeactivated(k.Codespace()).Result()
+	}
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeJailed,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, validator.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDeactivationEpoch, strconv.FormatUint(validator.EndEpoch, 10)),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// HandleMsgShareMint handles validator shares minted to delegator, adding them to delegation
+func HandleMsgShareMint(ctx sdk.Context, msg types.MsgShareMint, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling delegator share mint", "ValidatorID", msg.ID, "delegator", msg.Delegator)
+
+	if _, ok := k.GetValidatorFromValID(ctx, msg.ID); !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	// sequence id
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(msg.BlockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	delegation, found := k.GetDelegation(ctx, msg.Delegator, msg.ID)
+	if !found {
+		delegation = types.NewDelegation(msg.Delegator, msg.ID, hmTypes.ZeroInt(), hmTypes.ZeroInt())
+	}
+	delegation.Shares = delegation.Shares.Add(msg.Shares)
+	delegation.Amount = delegation.Amount.Add(msg.Tokens)
+	k.SetDelegation(ctx, delegation)
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeShareMint,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, delegation.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyTokens, delegation.Amount.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
+
+// HandleMsgShareBurn handles validator shares of delegator burned, removing them from delegation
+func HandleMsgShareBurn(ctx sdk.Context, msg types.MsgShareBurn, k Keeper) sdk.Result {
+	k.Logger(ctx).Debug("Handling delegator share burn", "ValidatorID", msg.ID, "delegator", msg.Delegator)
+
+	if _, ok := k.GetValidatorFromValID(ctx, msg.ID); !ok {
+		k.Logger(ctx).Error("Fetching of validator from store failed", "validatorID", msg.ID)
+		return hmCommon.ErrNoValidator(k.Codespace()).Result()
+	}
+
+	// sequence id
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(msg.BlockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
+
+	// check if incoming tx is older
+	if k.HasStakingSequence(ctx, sequence.String()) {
+		k.Logger(ctx).Error("Older invalid tx found")
+		return hmCommon.ErrOldTx(k.Codespace()).Result()
+	}
+
+	delegation, found := k.GetDelegation(ctx, msg.Delegator, msg.ID)
+	if !found || delegation.Shares.LT(msg.Shares) {
+		k.Logger(ctx).Error("Burning more shares than delegated", "validatorID", msg.ID, "delegator", msg.Delegator, "shares", msg.Shares)
+		return hmCommon.ErrInvalidMsg(k.Codespace(), fmt.Sprintf("Delegator %v has not enough shares of validator %v", msg.Delegator, msg.ID)).Result()
+	}
+
+	delegation.Shares = delegation.Shares.Sub(msg.Shares)
+	// tokens returned include rewards, so amount can't go below zero
+	if delegation.Amount.LT(msg.Tokens) {
+		delegation.Amount = hmTypes.ZeroInt()
+	} else {
+		delegation.Amount = delegation.Amount.Sub(msg.Tokens)
+	}
+	k.SetDelegation(ctx, delegation)
+
+	// save staking sequence
+	k.SetStakingSequence(ctx, sequence.String())
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeShareBurn,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.Delegator.String()),
+			sdk.NewAttribute(types.AttributeKeyShares, delegation.Shares.String()),
+			sdk.NewAttribute(types.AttributeKeyTokens, delegation.Amount.String()),
+		),
+	})
+
+	return sdk.Result{
+		Events: ctx.EventManager().Events(),
+	}
+}
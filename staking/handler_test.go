@@ -4,33 +4,27 @@ import (
 	"math/big"
 	"testing"
 
-	ethTypes "github.com/maticnetwork/bor/core/types"
-	"github.com/maticnetwork/heimdall/contracts/stakemanager"
+	hmCommon "github.com/maticnetwork/heimdall/common"
 	"github.com/maticnetwork/heimdall/helper"
-	"github.com/maticnetwork/heimdall/helper/mocks"
 	"github.com/maticnetwork/heimdall/staking"
 	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	cmn "github.com/maticnetwork/heimdall/test"
 	"github.com/maticnetwork/heimdall/types"
-	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
 func TestHandleMsgValidatorJoin(t *testing.T) {
-	contractCallerObj := mocks.IContractCaller{}
 	ctx, keeper, _ := cmn.CreateTestInput(t, false)
 	mockVals := cmn.GenRandomVal(1, 0, 10, 10, false, 1)
 	// select first validator from slice
 	mockVal := mockVals[0]
 	t.Log("Inserting ===>", "Validator", mockVal.Signer.String())
-	contractCallerObj.On("GetValidatorInfo", mock.Anything).Return(mockVal, nil)
 	// insert new validator
-	// msgTxHash := types.HeimdallHash("123")
 	msgTxHash := types.HexToHeimdallHash("123")
-	contractCallerObj.On("IsTxConfirmed", msgTxHash.EthHash()).Return(true)
-	msgValJoin := stakingTypes.NewMsgValidatorJoin(mockVal.Signer, uint64(mockVal.ID), mockVal.PubKey, msgTxHash, 0)
+	amount := types.NewIntFromBigInt(new(big.Int).SetInt64(1000000000000000000))
+	msgValJoin := stakingTypes.NewMsgValidatorJoin(mockVal.Signer, uint64(mockVal.ID), 1, amount, mockVal.PubKey, msgTxHash, 0)
 	t.Log("msg val join", msgValJoin)
-	got := staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
+	got := staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper)
 	require.True(t, got.IsOK(), "expected validator join to be ok, got %v", got)
 	// validator is stored properly and signer is created properly
 	storedVal, err := keeper.GetValidatorInfo(ctx, mockVal.Signer.Bytes())
@@ -43,13 +37,12 @@ func TestHandleMsgValidatorJoin(t *testing.T) {
 	require.Equal(t, mockVal.Signer.Bytes(), storedSigner.Bytes(), "Signer address in signer=>validator map should be same")
 	t.Log("Mapped validator ID and Signer ===>", "ID", mockVal.ID, "Signer", storedSigner.String())
 	// insert validator again
-	got = staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper, &contractCallerObj)
+	got = staking.HandleMsgValidatorJoin(ctx, msgValJoin, keeper)
 	require.True(t, !got.IsOK(), "expected validator join to be not-ok, got %v", got)
 	// check if new validator gets added in validator set
 }
 
 func TestHandleMsgValidatorUpdate(t *testing.T) {
-	contractCallerObj := mocks.IContractCaller{}
 	ctx, keeper, _ := cmn.CreateTestInput(t, false)
 
 	// pass 0 as time alive to generate non de-activated validators
@@ -64,17 +57,9 @@ func TestHandleMsgValidatorUpdate(t *testing.T) {
 	t.Log("To be Updated ===>", "Validator", newSigner[0].String())
 	// gen msg
 	msgTxHash := types.HexToHeimdallHash("123")
-	msg := stakingTypes.NewMsgSignerUpdate(newSigner[0].Signer, uint64(newSigner[0].ID), newSigner[0].PubKey, msgTxHash, 0)
-	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash()).Return(txreceipt, nil)
-	signerUpdateEvent := &stakemanager.StakemanagerSignerChange{
-		ValidatorId: new(big.Int).SetUint64(oldSigner.ID.Uint64()),
-		OldSigner:   oldSigner.Signer.EthAddress(),
-		NewSigner:   newSigner[0].Signer.EthAddress(),
-	}
-	contractCallerObj.On("DecodeSignerUpdateEvent", txreceipt, uint64(0)).Return(signerUpdateEvent, nil)
-
-	got := staking.HandleMsgSignerUpdate(ctx, msg, keeper, &contractCallerObj)
+	msg := stakingTypes.NewMsgSignerUpdate(newSigner[0].Signer, uint64(newSigner[0].ID), newSigner[0].PubKey, msgTxHash, 0, 10)
+
+	got := staking.HandleMsgSignerUpdate(ctx, msg, keeper)
 	require.True(t, got.IsOK(), "expected validator update to be ok, got %v", got)
 	newValidators := keeper.GetCurrentValidators(ctx)
 	require.Equal(t, len(oldValSet.Validators), len(newValidators), "Number of current validators should be equal")
@@ -100,25 +85,22 @@ func TestHandleMsgValidatorUpdate(t *testing.T) {
 }
 
 func TestHandleMsgValidatorExit(t *testing.T) {
-	contractCallerObj := mocks.IContractCaller{}
 	ctx, keeper, checkpointkeeper := cmn.CreateTestInput(t, false)
 	// pass 0 as time alive to generate non de-activated validators
 	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
 	validators := keeper.GetCurrentValidators(ctx)
 	msgTxHash := types.HexToHeimdallHash("123")
-	contractCallerObj.On("IsTxConfirmed", msgTxHash.EthHash()).Return(true)
 
 	validators[0].EndEpoch = 10
-	msg := stakingTypes.NewMsgValidatorExit(validators[0].Signer, uint64(validators[0].ID), msgTxHash, 0)
-	contractCallerObj.On("GetValidatorInfo", validators[0].ID).Return(validators[0], nil)
-	got := staking.HandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
+	msg := stakingTypes.NewMsgValidatorExit(validators[0].Signer, uint64(validators[0].ID), validators[0].EndEpoch, msgTxHash, 0)
+	got := staking.HandleMsgValidatorExit(ctx, msg, keeper)
 	require.True(t, got.IsOK(), "expected validator exit to be ok, got %v", got)
 	updatedValInfo, err := keeper.GetValidatorInfo(ctx, validators[0].Signer.Bytes())
 	require.Empty(t, err, "Unable to get validator info from val address,ValAddr:%v Error:%v ", validators[0].Signer.String(), err)
 	require.Equal(t, updatedValInfo.EndEpoch, validators[0].EndEpoch, "deactivation epoch should be set correctly")
 	_, found := keeper.GetValidatorFromValID(ctx, validators[0].ID)
 	require.True(t, found, "Validator should be present even after deactivation")
-	got = staking.HandleMsgValidatorExit(ctx, msg, keeper, &contractCallerObj)
+	got = staking.HandleMsgValidatorExit(ctx, msg, keeper)
 	require.True(t, !got.IsOK(), "validator already exited. cannot exit again")
 
 	currentVals := keeper.GetCurrentValidators(ctx)
@@ -130,7 +112,6 @@ func TestHandleMsgValidatorExit(t *testing.T) {
 }
 
 func TestHandleMsgStakeUpdate(t *testing.T) {
-	contractCallerObj := mocks.IContractCaller{}
 	ctx, keeper, _ := cmn.CreateTestInput(t, false)
 
 	// pass 0 as time alive to generate non de-activated validators
@@ -141,21 +122,59 @@ func TestHandleMsgStakeUpdate(t *testing.T) {
 	t.Log("To be Updated ===>", "Validator", oldVal.String())
 	// gen msg
 	msgTxHash := types.HexToHeimdallHash("123")
-	msg := stakingTypes.NewMsgStakeUpdate(oldVal.Signer, oldVal.ID.Uint64(), msgTxHash, 0)
-	txreceipt := &ethTypes.Receipt{BlockNumber: big.NewInt(10)}
-	contractCallerObj.On("GetConfirmedTxReceipt", msgTxHash.EthHash()).Return(txreceipt, nil)
-	stakeUpdateEvent := &stakemanager.StakemanagerStakeUpdate{
-		ValidatorId: new(big.Int).SetUint64(oldVal.ID.Uint64()),
-		OldAmount:   new(big.Int).SetInt64(oldVal.VotingPower),
-		NewAmount:   new(big.Int).SetInt64(2000000000000000000),
-	}
-
-	contractCallerObj.On("DecodeValidatorStakeUpdateEvent", txreceipt, uint64(0)).Return(stakeUpdateEvent, nil)
-
-	got := staking.HandleMsgStakeUpdate(ctx, msg, keeper, &contractCallerObj)
+	newAmount := new(big.Int).SetInt64(2000000000000000000)
+	msg := stakingTypes.NewMsgStakeUpdate(oldVal.Signer, oldVal.ID.Uint64(), types.NewIntFromBigInt(newAmount), msgTxHash, 0, 10)
+
+	got := staking.HandleMsgStakeUpdate(ctx, msg, keeper)
 	require.True(t, got.IsOK(), "expected validator stake update to be ok, got %v", got)
 	updatedVal, err := keeper.GetValidatorInfo(ctx, oldVal.Signer.Bytes())
 	require.Empty(t, err, "unable to fetch validator info %v-", err)
-	require.Equal(t, stakeUpdateEvent.NewAmount.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", stakeUpdateEvent.NewAmount.Uint64())
+	newPower, _ := helper.GetPowerFromAmount(newAmount)
+	require.Equal(t, newPower.Int64(), updatedVal.VotingPower, "Validator VotingPower should be updated to %v", newPower)
+
+}
+
+func TestHandleMsgShareMintAndBurn(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+
+	// pass 0 as time alive to generate non de-activated validators
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 0)
+	val := keeper.GetValidatorSet(ctx).Validators[0]
+	delegator := types.HexToHeimdallAddress("0x0000000000000000000000000000000000000001")
+	msgTxHash := types.HexToHeimdallHash("123")
+
+	mint := stakingTypes.NewMsgShareMint(val.Signer, val.ID.Uint64(), delegator, types.NewInt(10), types.NewInt(10), msgTxHash, 0, 10)
+	got := staking.HandleMsgShareMint(ctx, mint, keeper)
+	require.True(t, got.IsOK(), "expected share mint to be ok, got %v", got)
+
+	// same event is applied once
+	got = staking.HandleMsgShareMint(ctx, mint, keeper)
+	require.False(t, got.IsOK(), "expected replayed share mint to be not-ok, got %v", got)
+
+	// shares of unknown validator are rejected
+	got = staking.HandleMsgShareMint(ctx, stakingTypes.NewMsgShareMint(val.Signer, 100, delegator, types.NewInt(10), types.NewInt(10), msgTxHash, 1, 10), keeper)
+	require.False(t, got.IsOK(), "expected share mint of unknown validator to be not-ok, got %v", got)
+
+	// burning shares of unknown validator is rejected
+	got = staking.HandleMsgShareBurn(ctx, stakingTypes.NewMsgShareBurn(val.Signer, 100, delegator, types.NewInt(1), types.NewInt(1), msgTxHash, 1, 10), keeper)
+	require.Equal(t, hmCommon.ErrNoValidator(keeper.Codespace()).Result().Code, got.Code, "expected share burn of unknown validator to be not-ok, got %v", got)
+
+	// burning more shares than delegated is rejected
+	got = staking.HandleMsgShareBurn(ctx, stakingTypes.NewMsgShareBurn(val.Signer, val.ID.Uint64(), delegator, types.NewInt(11), types.NewInt(11), msgTxHash, 2, 10), keeper)
+	require.False(t, got.IsOK(), "expected share burn above delegation to be not-ok, got %v", got)
+
+	// burned tokens include rewards, amount doesn't go negative
+	got = staking.HandleMsgShareBurn(ctx, stakingTypes.NewMsgShareBurn(val.Signer, val.ID.Uint64(), delegator, types.NewInt(4), types.NewInt(12), msgTxHash, 3, 10), keeper)
+	require.True(t, got.IsOK(), "expected share burn to be ok, got %v", got)
+
+	delegation, found := keeper.GetDelegation(ctx, delegator, val.ID)
+	require.True(t, found)
+	require.True(t, delegation.Shares.Equal(types.NewInt(6)), "expected 6 shares left, got %v", delegation.Shares)
+	require.True(t, delegation.Amount.IsZero(), "expected no tokens left, got %v", delegation.Amount)
 
+	// burning remaining shares removes delegation
+	got = staking.HandleMsgShareBurn(ctx, stakingTypes.NewMsgShareBurn(val.Signer, val.ID.Uint64(), delegator, types.NewInt(6), types.NewInt(0), msgTxHash, 4, 10), keeper)
+	require.True(t, got.IsOK(), "expected share burn to be ok, got %v", got)
+	_, found = keeper.GetDelegation(ctx, delegator, val.ID)
+	require.False(t, found)
 }
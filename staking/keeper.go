@@ -25,6 +25,11 @@ var (
 	PrevDividendAccountMapKey = []byte{0x41} // store for dividend accounts before checkpoint ack.
 	DividendAccountMapKey     = []byte{0x42} // prefix for each key for Dividend Account Map
 	StakingSequenceKey        = []byte{0x24} // prefix for each key for staking sequence map
+	DelegationKey             = []byte{0x25} // prefix for each key to a delegation, by delegator and validator
+	ValidatorDelegatorKey     = []byte{0x26} // prefix for index of delegators by validator
+	ValidatorSetSnapshotKey   = []byte{0x27} // prefix for each key to validator set snapshot by height
+	CheckpointValidatorSetKey = []byte{0x28} // prefix for each key to validator set snapshot by checkpoint number
+	ValidatorSetChangeKey     = []byte{0x29} // key to flag that validators changed since last validator set update
 )
 
 // ModuleCommunicator manages different module interaction
@@ -112,6 +117,9 @@ func (k *Keeper) AddValidator(ctx sdk.Context, validator hmTypes.Validator) erro
 	// add validator to validator ID => SignerAddress map
 	k.SetValidatorIDToSignerAddr(ctx, validator.ID, validator.Signer)
 
+	// validator set is recomputed in end block
+	k.SetValidatorSetChangedFlag(ctx, true)
+
 	return nil
 }
 
@@ -261,6 +269,22 @@ func (k *Keeper) UpdateSigner(ctx sdk.Context, newSigner hmTypes.HeimdallAddress
 	return nil
 }
 
+// SetValidatorSetChangedFlag sets (or clears) flag that validators changed and
+// current validator set has to be recomputed in end block
+func (k *Keeper) SetValidatorSetChangedFlag(ctx sdk.Context, changed bool) {
+	store := ctx.KVStore(k.storeKey)
+	if changed {
+		store.Set(ValidatorSetChangeKey, DefaultValue)
+	} else {
+		store.Delete(ValidatorSetChangeKey)
+	}
+}
+
+// HasValidatorSetChanged returns true if validators changed since last validator set update
+func (k *Keeper) HasValidatorSetChanged(ctx sdk.Context) bool {
+	return ctx.KVStore(k.storeKey).Has(ValidatorSetChangeKey)
+}
+
 // UpdateValidatorSetInStore adds validator set to store
 func (k *Keeper) UpdateValidatorSetInStore(ctx sdk.Context, newValidatorSet hmTypes.ValidatorSet) error {
 	// TODO check if we may have to delay this by 1 height to sync with tendermint validator updates
@@ -289,6 +313,115 @@ func (k *Keeper) GetValidatorSet(ctx sdk.Context) (validatorSet hmTypes.Validato
 	return validatorSet
 }
 
+// GetValidatorSetSnapshotKey returns key for validator set snapshot at height
+func GetValidatorSetSnapshotKey(height int64) []byte {
+	return append(ValidatorSetSnapshotKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// GetCheckpointValidatorSetKey returns key for validator set snapshot of checkpoint
+func GetCheckpointValidatorSetKey(checkpointNumber uint64) []byte {
+	return append(CheckpointValidatorSetKey, sdk.Uint64ToBigEndian(checkpointNumber)...)
+}
+
+// SetValidatorSetSnapshot stores current validator set as snapshot for current height
+// and prunes snapshots which are out of history
+func (k *Keeper) SetValidatorSetSnapshot(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	snapshot := types.NewValidatorSetSnapshot(ctx.BlockHeight(), k.GetValidatorSet(ctx))
+	store.Set(GetValidatorSetSnapshotKey(snapshot.Height), k.cdc.MustMarshalBinaryBare(snapshot))
+
+	k.pruneValidatorSetSnapshots(ctx)
+}
+
+// GetValidatorSetAtHeight returns validator set in effect after block at given height
+func (k *Keeper) GetValidatorSetAtHeight(ctx sdk.Context, height int64) (snapshot types.ValidatorSetSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+
+	// latest snapshot taken at or before height
+	iterator := store.ReverseIterator(GetValidatorSetSnapshotKey(0), GetValidatorSetSnapshotKey(height+1))
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return snapshot, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &snapshot)
+	return snapshot, true
+}
+
+// SetCheckpointValidatorSet stores current validator set as the one which signed given checkpoint.
+// Checkpoints are numbered from 1, ie. header index on rootchain divided by child block interval.
+func (k *Keeper) SetCheckpointValidatorSet(ctx sdk.Context, checkpointNumber uint64) {
+	store := ctx.KVStore(k.storeKey)
+	snapshot := types.NewValidatorSetSnapshot(ctx.BlockHeight(), k.GetValidatorSet(ctx))
+	store.Set(GetCheckpointValidatorSetKey(checkpointNumber), k.cdc.MustMarshalBinaryBare(snapshot))
+}
+
+// GetCheckpointValidatorSet returns validator set which signed given checkpoint
+func (k *Keeper) GetCheckpointValidatorSet(ctx sdk.Context, checkpointNumber uint64) (snapshot types.ValidatorSetSnapshot, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetCheckpointValidatorSetKey(checkpointNumber))
+	if bz == nil {
+		return snapshot, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &snapshot)
+	return snapshot, true
+}
+
+// pruneValidatorSetSnapshots removes snapshots older than validator set history.
+// Latest snapshot before history window is kept, as it is still in effect at start of window.
+func (k *Keeper) pruneValidatorSetSnapshots(ctx sdk.Context) {
+	history := k.GetValidatorSetHistory(ctx)
+	if history == 0 || ctx.BlockHeight() <= int64(history) {
+		return
+	}
+	cutoff := ctx.BlockHeight() - int64(history)
+
+	store := ctx.KVStore(k.storeKey)
+
+	var keys [][]byte
+	iterator := store.Iterator(GetValidatorSetSnapshotKey(0), GetValidatorSetSnapshotKey(cutoff+1))
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	// keep last snapshot at or before cutoff
+	for i := 0; i+1 < len(keys); i++ {
+		store.Delete(keys[i])
+	}
+
+	keys = nil
+	checkpointIterator := sdk.KVStorePrefixIterator(store, CheckpointValidatorSetKey)
+	for ; checkpointIterator.Valid(); checkpointIterator.Next() {
+		var snapshot types.ValidatorSetSnapshot
+		k.cdc.MustUnmarshalBinaryBare(checkpointIterator.Value(), &snapshot)
+		// checkpoints are acked in order
+		if snapshot.Height >= cutoff {
+			break
+		}
+		keys = append(keys, checkpointIterator.Key())
+	}
+	checkpointIterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// GetValidatorSetHistory returns number of blocks validator set snapshots are kept for
+func (k *Keeper) GetValidatorSetHistory(ctx sdk.Context) uint64 {
+	history := types.DefaultValidatorSetHistory
+	k.paramSpace.GetIfExists(ctx, types.ParamStoreKeyValidatorSetHistory, &history)
+	return history
+}
+
+// SetValidatorSetHistory sets number of blocks validator set snapshots are kept for
+func (k *Keeper) SetValidatorSetHistory(ctx sdk.Context, history uint64) {
+	k.paramSpace.Set(ctx, types.ParamStoreKeyValidatorSetHistory, history)
+}
+
 // IncrementAccum increments accum for validator set by n times and replace validator set in store
 func (k *Keeper) IncrementAccum(ctx sdk.Context, times int) {
 	// get validator set
@@ -456,6 +589,142 @@ func (k *Keeper) AddFeeToDividendAccount(ctx sdk.Context, valID hmTypes.Validato
 	return nil
 }
 
+// AddSlashToDividendAccount adds slashed amount to dividend account of validator
+func (k *Keeper) AddSlashToDividendAccount(ctx sdk.Context, valID hmTypes.ValidatorID, amount *big.Int) sdk.Error {
+	// Get or create dividend account
+	var dividendAccount hmTypes.DividendAccount
+
+	if k.CheckIfDividendAccountExists(ctx, hmTypes.DividendAccountID(valID)) {
+		dividendAccount, _ = k.GetDividendAccountByID(ctx, hmTypes.DividendAccountID(valID))
+	} else {
+		dividendAccount = hmTypes.DividendAccount{
+			ID:            hmTypes.DividendAccountID(valID),
+			FeeAmount:     big.NewInt(0).String(),
+			SlashedAmount: big.NewInt(0).String(),
+		}
+	}
+
+	// update slashed amount
+	oldSlashedAmount, _ := big.NewInt(0).SetString(dividendAccount.SlashedAmount, 10)
+	dividendAccount.SlashedAmount = big.NewInt(0).Add(oldSlashedAmount, amount).String()
+
+	k.Logger(ctx).Info("Dividend Account slashed amount of validator ", "ID", dividendAccount.ID, "SlashedAmount", dividendAccount.SlashedAmount)
+	k.AddDividendAccount(ctx, dividendAccount)
+	return nil
+}
+
+// JailValidator jails validator, it is removed from current validator set by end blocker
+func (k *Keeper) JailValidator(ctx sdk.Context, valID hmTypes.ValidatorID) error {
+	validator, ok := k.GetValidatorFromValID(ctx, valID)
+	if !ok {
+		return errors.New("Validator not found")
+	}
+
+	validator.Jailed = true
+	return k.AddValidator(ctx, validator)
+}
+
+// UnjailValidator unjails validator, it is added back to current validator set by end blocker
+func (k *Keeper) UnjailValidator(ctx sdk.Context, valID hmTypes.ValidatorID) error {
+	validator, ok := k.GetValidatorFromValID(ctx, valID)
+	if !ok {
+		return errors.New("Validator not found")
+	}
+
+	validator.Jailed = false
+	return k.AddValidator(ctx, validator)
+}
+
+// GetDelegationKey returns key for delegation of delegator on validator
+func GetDelegationKey(delegator hmTypes.HeimdallAddress, valID hmTypes.ValidatorID) []byte {
+	return append(GetDelegationsKey(delegator), sdk.Uint64ToBigEndian(uint64(valID))...)
+}
+
+// GetDelegationsKey returns prefix key for delegations of delegator
+func GetDelegationsKey(delegator hmTypes.HeimdallAddress) []byte {
+	return append(DelegationKey, delegator.Bytes()...)
+}
+
+// GetValidatorDelegatorKey returns index key for delegator of validator
+func GetValidatorDelegatorKey(valID hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress) []byte {
+	return append(GetValidatorDelegatorsKey(valID), delegator.Bytes()...)
+}
+
+// GetValidatorDelegatorsKey returns prefix key for delegators of validator
+func GetValidatorDelegatorsKey(valID hmTypes.ValidatorID) []byte {
+	return append(ValidatorDelegatorKey, sdk.Uint64ToBigEndian(uint64(valID))...)
+}
+
+// SetDelegation stores delegation, removing it once delegator has no shares left
+func (k *Keeper) SetDelegation(ctx sdk.Context, delegation types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+
+	if !delegation.Shares.IsPositive() {
+		store.Delete(GetDelegationKey(delegation.Delegator, delegation.ValidatorID))
+		store.Delete(GetValidatorDelegatorKey(delegation.ValidatorID, delegation.Delegator))
+		return
+	}
+
+	store.Set(GetDelegationKey(delegation.Delegator, delegation.ValidatorID), k.cdc.MustMarshalBinaryBare(delegation))
+	store.Set(GetValidatorDelegatorKey(delegation.ValidatorID, delegation.Delegator), DefaultValue)
+}
+
+// GetDelegation returns delegation of delegator on validator
+func (k *Keeper) GetDelegation(ctx sdk.Context, delegator hmTypes.HeimdallAddress, valID hmTypes.ValidatorID) (delegation types.Delegation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(GetDelegationKey(delegator, valID))
+	if bz == nil {
+		return delegation, false
+	}
+
+	k.cdc.MustUnmarshalBinaryBare(bz, &delegation)
+	return delegation, true
+}
+
+// GetDelegatorDelegations returns delegations of delegator on all validators
+func (k *Keeper) GetDelegatorDelegations(ctx sdk.Context, delegator hmTypes.HeimdallAddress) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, GetDelegationsKey(delegator))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+	return
+}
+
+// GetValidatorDelegations returns delegations of all delegators on validator
+func (k *Keeper) GetValidatorDelegations(ctx sdk.Context, valID hmTypes.ValidatorID) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	prefix := GetValidatorDelegatorsKey(valID)
+	iterator := sdk.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		delegator := hmTypes.BytesToHeimdallAddress(iterator.Key()[len(prefix):])
+		if delegation, found := k.GetDelegation(ctx, delegator, valID); found {
+			delegations = append(delegations, delegation)
+		}
+	}
+	return
+}
+
+// GetAllDelegations returns all delegations
+func (k *Keeper) GetAllDelegations(ctx sdk.Context) (delegations []types.Delegation) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := sdk.KVStorePrefixIterator(store, DelegationKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var delegation types.Delegation
+		k.cdc.MustUnmarshalBinaryBare(iterator.Value(), &delegation)
+		delegations = append(delegations, delegation)
+	}
+	return
+}
+
 // IterateDividendAccountsByPrefixAndApplyFn iterate dividendAccounts and apply the given function.
 func (k *Keeper) IterateDividendAccountsByPrefixAndApplyFn(ctx sdk.Context, prefix []byte, f func(dividendAccount hmTypes.DividendAccount) error) {
 	store := ctx.KVStore(k.storeKey)
@@ -2,8 +2,10 @@ package staking_test
 
 import (
 	"encoding/hex"
+	"github.com/maticnetwork/heimdall/checkpoint"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
 	"github.com/maticnetwork/heimdall/helper"
+	stakingTypes "github.com/maticnetwork/heimdall/staking/types"
 	cmn "github.com/maticnetwork/heimdall/test"
 	"github.com/maticnetwork/heimdall/types"
 	"github.com/stretchr/testify/require"
@@ -292,6 +294,114 @@ func TestDividendAccount(t *testing.T) {
 	t.Log(dividendAccountInStore)
 }
 
+func TestDelegation(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	delegator := types.HexToHeimdallAddress("0x0000000000000000000000000000000000000001")
+
+	delegation := stakingTypes.NewDelegation(delegator, types.NewValidatorID(1), types.NewInt(10), types.NewInt(10))
+	keeper.SetDelegation(ctx, delegation)
+	keeper.SetDelegation(ctx, stakingTypes.NewDelegation(delegator, types.NewValidatorID(2), types.NewInt(5), types.NewInt(5)))
+
+	stored, found := keeper.GetDelegation(ctx, delegator, types.NewValidatorID(1))
+	require.True(t, found)
+	require.True(t, stored.Shares.Equal(delegation.Shares))
+	require.Len(t, keeper.GetDelegatorDelegations(ctx, delegator), 2)
+	require.Len(t, keeper.GetValidatorDelegations(ctx, types.NewValidatorID(1)), 1)
+
+	// delegation without shares is removed
+	delegation.Shares = types.ZeroInt()
+	keeper.SetDelegation(ctx, delegation)
+	_, found = keeper.GetDelegation(ctx, delegator, types.NewValidatorID(1))
+	require.False(t, found)
+	require.Empty(t, keeper.GetValidatorDelegations(ctx, types.NewValidatorID(1)))
+	require.Len(t, keeper.GetAllDelegations(ctx), 1)
+}
+
+func TestValidatorSetSnapshot(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	keeper.SetValidatorSetHistory(ctx, 10)
+
+	validators := cmn.GenRandomVal(2, 0, 10, uint64(10), false, 1)
+	keeper.UpdateValidatorSetInStore(ctx, *types.NewValidatorSet([]*types.Validator{&validators[0]}))
+	keeper.SetValidatorSetSnapshot(ctx.WithBlockHeight(5))
+	keeper.SetCheckpointValidatorSet(ctx.WithBlockHeight(5), 1)
+
+	keeper.UpdateValidatorSetInStore(ctx, *types.NewValidatorSet([]*types.Validator{&validators[0], &validators[1]}))
+	keeper.SetValidatorSetSnapshot(ctx.WithBlockHeight(8))
+
+	_, found := keeper.GetValidatorSetAtHeight(ctx, 4)
+	require.False(t, found)
+
+	snapshot, found := keeper.GetValidatorSetAtHeight(ctx, 7)
+	require.True(t, found)
+	require.Equal(t, int64(5), snapshot.Height)
+	require.Len(t, snapshot.ValidatorSet.Validators, 1)
+
+	snapshot, found = keeper.GetValidatorSetAtHeight(ctx, 100)
+	require.True(t, found)
+	require.Len(t, snapshot.ValidatorSet.Validators, 2)
+
+	snapshot, found = keeper.GetCheckpointValidatorSet(ctx, 1)
+	require.True(t, found)
+	require.Len(t, snapshot.ValidatorSet.Validators, 1)
+
+	// snapshot at height 8 is still in effect at start of history window
+	keeper.SetValidatorSetSnapshot(ctx.WithBlockHeight(20))
+	_, found = keeper.GetValidatorSetAtHeight(ctx, 7)
+	require.False(t, found)
+	_, found = keeper.GetCheckpointValidatorSet(ctx, 1)
+	require.False(t, found)
+
+	snapshot, found = keeper.GetValidatorSetAtHeight(ctx, 10)
+	require.True(t, found)
+	require.Equal(t, int64(8), snapshot.Height)
+}
+
+func TestValidatorSetChangedFlag(t *testing.T) {
+	ctx, keeper, _ := cmn.CreateTestInput(t, false)
+	require.False(t, keeper.HasValidatorSetChanged(ctx))
+
+	validator := cmn.GenRandomVal(1, 0, 10, uint64(10), false, 1)[0]
+	require.NoError(t, keeper.AddValidator(ctx, validator))
+	require.True(t, keeper.HasValidatorSetChanged(ctx))
+
+	keeper.SetValidatorSetChangedFlag(ctx, false)
+	require.False(t, keeper.HasValidatorSetChanged(ctx))
+
+	// jailing changes validator set as well
+	require.NoError(t, keeper.JailValidator(ctx, validator.ID))
+	require.True(t, keeper.HasValidatorSetChanged(ctx))
+}
+
+func TestCheckpointAckValidatorSet(t *testing.T) {
+	ctx, keeper, checkpointKeeper := cmn.CreateTestInput(t, false)
+	cmn.LoadValidatorSet(4, t, keeper, ctx, false, 10)
+	handler := checkpoint.NewHandler(checkpointKeeper)
+
+	ack := func(checkpointNumber uint64, start uint64, end uint64) {
+		vs := keeper.GetValidatorSet(ctx)
+		proposer := vs.GetProposer().Signer
+		rootHash := types.HexToHeimdallHash("123")
+		require.NoError(t, checkpointKeeper.SetCheckpointBuffer(ctx, types.CreateBlock(start, end, rootHash, rootHash, proposer, uint64(ctx.BlockTime().Unix()))))
+
+		headerBlock := checkpointNumber * checkpointTypes.ChildBlockInterval
+		got := handler(ctx, checkpointTypes.NewMsgCheckpointAck(proposer, headerBlock, proposer, start, end, rootHash, types.HexToHeimdallHash("123"), 0))
+		require.True(t, got.IsOK(), "expected checkpoint ack to be ok, got %v", got)
+	}
+
+	ack(1, 0, 255)
+	ack(2, 256, 511)
+
+	// snapshots are keyed by checkpoint number, not header index on rootchain
+	for _, checkpointNumber := range []uint64{1, 2} {
+		snapshot, found := keeper.GetCheckpointValidatorSet(ctx, checkpointNumber)
+		require.True(t, found, "expected validator set for checkpoint %v", checkpointNumber)
+		require.Len(t, snapshot.ValidatorSet.Validators, 4)
+	}
+	_, found := keeper.GetCheckpointValidatorSet(ctx, checkpointTypes.ChildBlockInterval)
+	require.False(t, found)
+}
+
 func TestDividendAccountTree(t *testing.T) {
 
 	divAccounts := cmn.GenRandomDividendAccount(3, 1, true)
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"math/big"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	abci "github.com/tendermint/tendermint/abci/types"
@@ -38,6 +39,16 @@ func NewQuerier(keeper Keeper) sdk.Querier {
 			return handleQueryAccountProof(ctx, req, keeper)
 		case types.QueryVerifyAccountProof:
 			return handleQueryVerifyAccountProof(ctx, req, keeper)
+		case types.QueryStakingSequence:
+			return handleQueryStakingSequence(ctx, req, keeper)
+		case types.QueryDelegatorDelegations:
+			return handleQueryDelegatorDelegations(ctx, req, keeper)
+		case types.QueryValidatorDelegations:
+			return handleQueryValidatorDelegations(ctx, req, keeper)
+		case types.QueryValidatorSetAtHeight:
+			return handleQueryValidatorSetAtHeight(ctx, req, keeper)
+		case types.QueryCheckpointValSet:
+			return handleQueryCheckpointValidatorSet(ctx, req, keeper)
 
 		default:
 			return nil, sdk.ErrUnknownRequest("unknown staking query endpoint")
@@ -235,3 +246,103 @@ func handleQueryVerifyAccountProof(ctx sdk.Context, req abci.RequestQuery, keepe
 	}
 	return bz, nil
 }
+
+func handleQueryStakingSequence(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryStakingSequenceParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	contractCallerObj, err := helper.NewContractCaller()
+	if err != nil {
+		return nil, sdk.ErrInternal(err.Error())
+	}
+
+	// get main tx receipt
+	receipt, err := contractCallerObj.GetConfirmedTxReceipt(hmTypes.HexToHeimdallHash(params.TxHash).EthHash())
+	if err != nil || receipt == nil {
+		return nil, sdk.ErrInternal("Transaction is not confirmed yet. Please wait for sometime and try again")
+	}
+
+	// sequence id
+	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence.Add(sequence, new(big.Int).SetUint64(params.LogIndex))
+
+	// empty result if tx is not processed yet
+	if !keeper.HasStakingSequence(ctx, sequence.String()) {
+		return nil, nil
+	}
+
+	// json record
+	bz, err := json.Marshal(sequence)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryDelegatorDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryDelegatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// json record
+	bz, err := json.Marshal(keeper.GetDelegatorDelegations(ctx, params.Delegator))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorDelegations(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryValidatorParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	// json record
+	bz, err := json.Marshal(keeper.GetValidatorDelegations(ctx, params.ValidatorID))
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryValidatorSetAtHeight(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryHeightParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	snapshot, found := keeper.GetValidatorSetAtHeight(ctx, params.Height)
+	if !found {
+		return nil, sdk.ErrUnknownRequest("No validator set found for height")
+	}
+
+	// json record
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
+
+func handleQueryCheckpointValidatorSet(ctx sdk.Context, req abci.RequestQuery, keeper Keeper) ([]byte, sdk.Error) {
+	var params types.QueryCheckpointParams
+	if err := keeper.cdc.UnmarshalJSON(req.Data, &params); err != nil {
+		return nil, sdk.ErrInternal(fmt.Sprintf("failed to parse params: %s", err))
+	}
+
+	snapshot, found := keeper.GetCheckpointValidatorSet(ctx, params.Number)
+	if !found {
+		return nil, sdk.ErrUnknownRequest("No validator set found for checkpoint")
+	}
+
+	// json record
+	bz, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, sdk.ErrInternal(sdk.AppendMsgToErr("could not marshal result to JSON", err.Error()))
+	}
+	return bz, nil
+}
@@ -0,0 +1,240 @@
+package staking
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ethTypes "github.com/maticnetwork/bor/core/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/staking/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var sideTxLogger = helper.Logger.With("module", "staking/side")
+
+// NewSideTxHandler returns handler which validates staking msgs against mainchain.
+// It is run by validators outside of consensus (see sidechannel).
+func NewSideTxHandler(contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(msg sdk.Msg) (bool, error) {
+		switch msg := msg.(type) {
+		case types.MsgValidatorJoin:
+			return SideHandleMsgValidatorJoin(msg, contractCaller)
+		case types.MsgValidatorExit:
+			return SideHandleMsgValidatorExit(msg, contractCaller)
+		case types.MsgSignerUpdate:
+			return SideHandleMsgSignerUpdate(msg, contractCaller)
+		case types.MsgStakeUpdate:
+			return SideHandleMsgStakeUpdate(msg, contractCaller)
+		case types.MsgValidatorReStake:
+			return SideHandleMsgValidatorReStake(msg, contractCaller)
+		case types.MsgValidatorJailed:
+			return SideHandleMsgValidatorJailed(msg, contractCaller)
+		case types.MsgShareMint:
+			return SideHandleMsgShareMint(msg, contractCaller)
+		case types.MsgShareBurn:
+			return SideHandleMsgShareBurn(msg, contractCaller)
+		default:
+			return false, nil
+		}
+	}
+}
+
+// SideHandleMsgValidatorJoin validates validator join against staked event
+func SideHandleMsgValidatorJoin(msg types.MsgValidatorJoin, contractCaller helper.IContractCaller) (bool, error) {
+	// get main tx receipt, retried until confirmed
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorJoinEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch validator join log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	if !bytes.Equal(msg.SignerPubKey.Address().Bytes(), eventLog.Signer.Bytes()) {
+		sideTxLogger.Error("Signer Address does not match", "msgValidator", msg.SignerPubKey.Address().String(), "mainchainValidator", eventLog.Signer.Hex())
+		return false, nil
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() ||
+		eventLog.ActivationEpoch.Uint64() != msg.ActivationEpoch ||
+		eventLog.Amount.Cmp(msg.Amount.BigInt()) != 0 {
+		sideTxLogger.Error("Validator join msg doesn't match log", "msg", msg, "validatorIdFromTx", eventLog.ValidatorId, "activationEpoch", eventLog.ActivationEpoch, "amount", eventLog.Amount)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SideHandleMsgStakeUpdate validates stake update against stake update event
+func SideHandleMsgStakeUpdate(msg types.MsgStakeUpdate, contractCaller helper.IContractCaller) (bool, error) {
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorStakeUpdateEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch stake update log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	if !isReceiptBlock(receipt, msg.BlockNumber) ||
+		eventLog.ValidatorId.Uint64() != msg.ID.Uint64() ||
+		eventLog.NewAmount.Cmp(msg.NewAmount.BigInt()) != 0 {
+		sideTxLogger.Error("Stake update msg doesn't match log", "msg", msg, "validatorIdFromTx", eventLog.ValidatorId, "newAmount", eventLog.NewAmount, "blockNumber", receipt.BlockNumber)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SideHandleMsgSignerUpdate validates signer update against signer change event
+func SideHandleMsgSignerUpdate(msg types.MsgSignerUpdate, contractCaller helper.IContractCaller) (bool, error) {
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeSignerUpdateEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch signer update log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	newSigner := msg.NewSignerPubKey.Address()
+	if !isReceiptBlock(receipt, msg.BlockNumber) ||
+		eventLog.ValidatorId.Uint64() != msg.ID.Uint64() ||
+		!bytes.Equal(eventLog.NewSigner.Bytes(), newSigner.Bytes()) {
+		sideTxLogger.Error("Signer update msg doesn't match log", "msg", msg, "validatorIdFromTx", eventLog.ValidatorId, "signerTx", eventLog.NewSigner.String(), "blockNumber", receipt.BlockNumber)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SideHandleMsgValidatorExit validates validator exit against unstake init event
+func SideHandleMsgValidatorExit(msg types.MsgValidatorExit, contractCaller helper.IContractCaller) (bool, error) {
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorExitEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch unstake log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() ||
+		eventLog.DeactivationEpoch.Uint64() != msg.DeactivationEpoch {
+		sideTxLogger.Error("Validator exit msg doesn't match log", "msg", msg, "validatorIdFromTx", eventLog.ValidatorId, "deactivationEpoch", eventLog.DeactivationEpoch)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SideHandleMsgValidatorReStake validates validator restake against restaked event
+func SideHandleMsgValidatorReStake(msg types.MsgValidatorReStake, contractCaller helper.IContractCaller) (bool, error) {
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorReStakeEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch restake log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	if !isReceiptBlock(receipt, msg.BlockNumber) ||
+		eventLog.ValidatorId.Uint64() != msg.ID.Uint64() ||
+		eventLog.Total.Cmp(msg.Total.BigInt()) != 0 {
+		sideTxLogger.Error("Validator restake msg doesn't match log", "msg", msg, "validatorIdFromTx", eventLog.ValidatorId, "total", eventLog.Total, "blockNumber", receipt.BlockNumber)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SideHandleMsgValidatorJailed validates validator jailed against jailed event
+func SideHandleMsgValidatorJailed(msg types.MsgValidatorJailed, contractCaller helper.IContractCaller) (bool, error) {
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorJailedEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch jailed log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	if !isReceiptBlock(receipt, msg.BlockNumber) ||
+		eventLog.ValidatorId.Uint64() != msg.ID.Uint64() ||
+		eventLog.ExitEpoch.Uint64() != msg.ExitEpoch {
+		sideTxLogger.Error("Validator jailed msg doesn't match log", "msg", msg, "validatorIdFromTx", eventLog.ValidatorId, "exitEpoch", eventLog.ExitEpoch, "blockNumber", receipt.BlockNumber)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SideHandleMsgShareMint validates delegator share mint against share minted event
+func SideHandleMsgShareMint(msg types.MsgShareMint, contractCaller helper.IContractCaller) (bool, error) {
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeShareMintedEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch share minted log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	if !isReceiptBlock(receipt, msg.BlockNumber) ||
+		eventLog.ValidatorId.Uint64() != msg.ID.Uint64() ||
+		!bytes.Equal(eventLog.User.Bytes(), msg.Delegator.Bytes()) ||
+		eventLog.Amount.Cmp(msg.Shares.BigInt()) != 0 ||
+		eventLog.Tokens.Cmp(msg.Tokens.BigInt()) != 0 {
+		sideTxLogger.Error("Share mint msg doesn't match log", "msg", msg, "validatorIdFromTx", eventLog.ValidatorId, "user", eventLog.User.Hex(), "shares", eventLog.Amount, "tokens", eventLog.Tokens, "blockNumber", receipt.BlockNumber)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// SideHandleMsgShareBurn validates delegator share burn against share burned event
+func SideHandleMsgShareBurn(msg types.MsgShareBurn, contractCaller helper.IContractCaller) (bool, error) {
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeShareBurnedEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch share burned log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	if !isReceiptBlock(receipt, msg.BlockNumber) ||
+		eventLog.ValidatorId.Uint64() != msg.ID.Uint64() ||
+		!bytes.Equal(eventLog.User.Bytes(), msg.Delegator.Bytes()) ||
+		eventLog.Amount.Cmp(msg.Shares.BigInt()) != 0 ||
+		eventLog.Tokens.Cmp(msg.Tokens.BigInt()) != 0 {
+		sideTxLogger.Error("Share burn msg doesn't match log", "msg", msg, "validatorIdFromTx", eventLog.ValidatorId, "user", eventLog.User.Hex(), "shares", eventLog.Amount, "tokens", eventLog.Tokens, "blockNumber", receipt.BlockNumber)
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// isReceiptBlock checks if receipt is from given block, block number is used for staking sequence
+func isReceiptBlock(receipt *ethTypes.Receipt, blockNumber uint64) bool {
+	return receipt.BlockNumber != nil && receipt.BlockNumber.Uint64() == blockNumber
+}
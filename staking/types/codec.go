@@ -13,6 +13,10 @@ func RegisterCodec(cdc *codec.Codec) {
 	cdc.RegisterConcrete(MsgSignerUpdate{}, "staking/MsgSignerUpdate", nil)
 	cdc.RegisterConcrete(MsgValidatorExit{}, "staking/MsgValidatorExit", nil)
 	cdc.RegisterConcrete(MsgStakeUpdate{}, "staking/MsgStakeUpdate", nil)
+	cdc.RegisterConcrete(MsgValidatorReStake{}, "staking/MsgValidatorReStake", nil)
+	cdc.RegisterConcrete(MsgValidatorJailed{}, "staking/MsgValidatorJailed", nil)
+	cdc.RegisterConcrete(MsgShareMint{}, "staking/MsgShareMint", nil)
+	cdc.RegisterConcrete(MsgShareBurn{}, "staking/MsgShareBurn", nil)
 }
 
 func RegisterPulp(pulp *authTypes.Pulp) {
@@ -20,6 +24,10 @@ func RegisterPulp(pulp *authTypes.Pulp) {
 	pulp.RegisterConcrete(MsgSignerUpdate{})
 	pulp.RegisterConcrete(MsgValidatorExit{})
 	pulp.RegisterConcrete(MsgStakeUpdate{})
+	pulp.RegisterConcrete(MsgValidatorReStake{})
+	pulp.RegisterConcrete(MsgValidatorJailed{})
+	pulp.RegisterConcrete(MsgShareMint{})
+	pulp.RegisterConcrete(MsgShareBurn{})
 }
 
 // ModuleCdc generic sealed codec to be used throughout module
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// Delegation shares and staked tokens of delegator on validator, mirrored from
+// share mint/burn events of validator share contract on mainchain
+type Delegation struct {
+	Delegator   hmTypes.HeimdallAddress `json:"delegator" yaml:"delegator"`
+	ValidatorID hmTypes.ValidatorID     `json:"validator_id" yaml:"validator_id"`
+	Shares      hmTypes.Int             `json:"shares" yaml:"shares"` // validator shares held by delegator
+	Amount      hmTypes.Int             `json:"amount" yaml:"amount"` // tokens delegated for the shares
+}
+
+// NewDelegation creates new delegation
+func NewDelegation(delegator hmTypes.HeimdallAddress, validatorID hmTypes.ValidatorID, shares hmTypes.Int, amount hmTypes.Int) Delegation {
+	return Delegation{
+		Delegator:   delegator,
+		ValidatorID: validatorID,
+		Shares:      shares,
+		Amount:      amount,
+	}
+}
+
+// String returns human readable delegation
+func (d Delegation) String() string {
+	return fmt.Sprintf(
+		"Delegation{%v %v %v %v}",
+		d.Delegator,
+		d.ValidatorID,
+		d.Shares,
+		d.Amount,
+	)
+}
@@ -7,12 +7,20 @@ var (
 	EventTypeSignerUpdate  = "signer-update"
 	EventTypeStakeUpdate   = "stake-update"
 	EventTypeValidatorExit = "validator-exit"
+	EventTypeReStake       = "validator-restake"
+	EventTypeJailed        = "validator-jailed"
+	EventTypeShareMint     = "delegator-share-mint"
+	EventTypeShareBurn     = "delegator-share-burn"
 
 	AttributeKeySigner            = "signer"
 	AttributeKeyDeactivationEpoch = "deactivation-epoch"
 	AttributeKeyActivationEpoch   = "activation-epoch"
 	AttributeKeyValidatorID       = "validator-id"
 	AttributeKeyUpdatedAt         = "updated-at"
+	AttributeKeyVotingPower       = "voting-power"
+	AttributeKeyDelegator         = "delegator"
+	AttributeKeyShares            = "shares"
+	AttributeKeyTokens            = "tokens"
 
 	AttributeValueCategory = ModuleName
 )
@@ -36,6 +36,7 @@ type GenesisState struct {
 	CurrentValSet    hmTypes.ValidatorSet      `json:"current_val_set" yaml:"current_val_set"`
 	DividentAccounts []hmTypes.DividendAccount `json:"dividend_accounts" yaml:"dividend_accounts"`
 	StakingSequences []string                  `json:"staking_sequences" yaml:"staking_sequences"`
+	Delegations      []Delegation              `json:"delegations" yaml:"delegations"`
 }
 
 // NewGenesisState creates a new genesis state.
@@ -44,18 +45,20 @@ func NewGenesisState(
 	currentValSet hmTypes.ValidatorSet,
 	dividentAccounts []hmTypes.DividendAccount,
 	stakingSequences []string,
+	delegations []Delegation,
 ) GenesisState {
 	return GenesisState{
 		Validators:       validators,
 		CurrentValSet:    currentValSet,
 		DividentAccounts: dividentAccounts,
 		StakingSequences: stakingSequences,
+		Delegations:      delegations,
 	}
 }
 
 // DefaultGenesisState returns a default genesis state
 func DefaultGenesisState() GenesisState {
-	return NewGenesisState(nil, hmTypes.ValidatorSet{}, nil, nil)
+	return NewGenesisState(nil, hmTypes.ValidatorSet{}, nil, nil, nil)
 }
 
 // ValidateGenesis performs basic validation of bor genesis data returning an
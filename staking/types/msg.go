@@ -19,30 +19,37 @@ var cdc = codec.New()
 //
 
 var _ sdk.Msg = &MsgValidatorJoin{}
+var _ hmTypes.SideTxMsg = MsgValidatorJoin{}
 
 type MsgValidatorJoin struct {
-	From         hmTypes.HeimdallAddress `json:"from"`
-	ID           hmTypes.ValidatorID     `json:"id"`
-	SignerPubKey hmTypes.PubKey          `json:"pub_key"`
-	TxHash       hmTypes.HeimdallHash    `json:"tx_hash"`
-	LogIndex     uint64                  `json:"log_index"`
+	From            hmTypes.HeimdallAddress `json:"from"`
+	ID              hmTypes.ValidatorID     `json:"id"`
+	ActivationEpoch uint64                  `json:"activation_epoch"`
+	Amount          hmTypes.Int             `json:"amount"`
+	SignerPubKey    hmTypes.PubKey          `json:"pub_key"`
+	TxHash          hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex        uint64                  `json:"log_index"`
 }
 
 // NewMsgValidatorJoin creates new validator-join
 func NewMsgValidatorJoin(
 	from hmTypes.HeimdallAddress,
 	id uint64,
+	activationEpoch uint64,
+	amount hmTypes.Int,
 	pubkey hmTypes.PubKey,
 	txhash hmTypes.HeimdallHash,
 	logIndex uint64,
 ) MsgValidatorJoin {
 
 	return MsgValidatorJoin{
-		From:         from,
-		ID:           hmTypes.NewValidatorID(id),
-		SignerPubKey: pubkey,
-		TxHash:       txhash,
-		LogIndex:     logIndex,
+		From:            from,
+		ID:              hmTypes.NewValidatorID(id),
+		ActivationEpoch: activationEpoch,
+		Amount:          amount,
+		SignerPubKey:    pubkey,
+		TxHash:          txhash,
+		LogIndex:        logIndex,
 	}
 }
 
@@ -71,6 +78,10 @@ func (msg MsgValidatorJoin) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
 	}
 
+	if msg.Amount.I == nil || msg.Amount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Amount.I)
+	}
+
 	if bytes.Equal(msg.SignerPubKey.Bytes(), helper.ZeroPubKey.Bytes()) {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid pub key %v", msg.SignerPubKey.String())
 	}
@@ -92,6 +103,12 @@ func (msg MsgValidatorJoin) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
 
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgValidatorJoin) GetSideSignBytes() []byte {
+	msg.From = hmTypes.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
 //
 // Stake update
 //
@@ -101,22 +118,27 @@ func (msg MsgValidatorJoin) GetLogIndex() uint64 {
 //
 
 var _ sdk.Msg = &MsgStakeUpdate{}
+var _ hmTypes.SideTxMsg = MsgStakeUpdate{}
 
 // MsgStakeUpdate represents stake update
 type MsgStakeUpdate struct {
-	From     hmTypes.HeimdallAddress `json:"from"`
-	ID       hmTypes.ValidatorID     `json:"id"`
-	TxHash   hmTypes.HeimdallHash    `json:"tx_hash"`
-	LogIndex uint64                  `json:"log_index"`
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	NewAmount   hmTypes.Int             `json:"amount"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
 }
 
 // NewMsgStakeUpdate represents stake update
-func NewMsgStakeUpdate(from hmTypes.HeimdallAddress, id uint64, txhash hmTypes.HeimdallHash, logIndex uint64) MsgStakeUpdate {
+func NewMsgStakeUpdate(from hmTypes.HeimdallAddress, id uint64, newAmount hmTypes.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgStakeUpdate {
 	return MsgStakeUpdate{
-		From:     from,
-		ID:       hmTypes.NewValidatorID(id),
-		TxHash:   txhash,
-		LogIndex: logIndex,
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		NewAmount:   newAmount,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
 	}
 }
 
@@ -145,6 +167,10 @@ func (msg MsgStakeUpdate) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
 	}
 
+	if msg.NewAmount.I == nil || msg.NewAmount.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.NewAmount.I)
+	}
+
 	if msg.From.Empty() {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
 	}
@@ -162,10 +188,17 @@ func (msg MsgStakeUpdate) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
 
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgStakeUpdate) GetSideSignBytes() []byte {
+	msg.From = hmTypes.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
 //
 // validator update
 //
 var _ sdk.Msg = &MsgSignerUpdate{}
+var _ hmTypes.SideTxMsg = MsgSignerUpdate{}
 
 // MsgSignerUpdate signer update struct
 // TODO add old signer sig check
@@ -175,6 +208,7 @@ type MsgSignerUpdate struct {
 	NewSignerPubKey hmTypes.PubKey          `json:"pubKey"`
 	TxHash          hmTypes.HeimdallHash    `json:"tx_hash"`
 	LogIndex        uint64                  `json:"log_index"`
+	BlockNumber     uint64                  `json:"block_number"`
 }
 
 func NewMsgSignerUpdate(
@@ -183,6 +217,7 @@ func NewMsgSignerUpdate(
 	pubKey hmTypes.PubKey,
 	txhash hmTypes.HeimdallHash,
 	logIndex uint64,
+	blockNumber uint64,
 ) MsgSignerUpdate {
 	return MsgSignerUpdate{
 		From:            from,
@@ -190,6 +225,7 @@ func NewMsgSignerUpdate(
 		NewSignerPubKey: pubKey,
 		TxHash:          txhash,
 		LogIndex:        logIndex,
+		BlockNumber:     blockNumber,
 	}
 }
 
@@ -239,25 +275,34 @@ func (msg MsgSignerUpdate) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
 
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgSignerUpdate) GetSideSignBytes() []byte {
+	msg.From = hmTypes.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
 //
 // validator exit
 //
 
 var _ sdk.Msg = &MsgValidatorExit{}
+var _ hmTypes.SideTxMsg = MsgValidatorExit{}
 
 type MsgValidatorExit struct {
-	From     hmTypes.HeimdallAddress `json:"from"`
-	ID       hmTypes.ValidatorID     `json:"id"`
-	TxHash   hmTypes.HeimdallHash    `json:"tx_hash"`
-	LogIndex uint64                  `json:"log_index"`
+	From              hmTypes.HeimdallAddress `json:"from"`
+	ID                hmTypes.ValidatorID     `json:"id"`
+	DeactivationEpoch uint64                  `json:"deactivation_epoch"`
+	TxHash            hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex          uint64                  `json:"log_index"`
 }
 
-func NewMsgValidatorExit(from hmTypes.HeimdallAddress, id uint64, txhash hmTypes.HeimdallHash, logIndex uint64) MsgValidatorExit {
+func NewMsgValidatorExit(from hmTypes.HeimdallAddress, id uint64, deactivationEpoch uint64, txhash hmTypes.HeimdallHash, logIndex uint64) MsgValidatorExit {
 	return MsgValidatorExit{
-		From:     from,
-		ID:       hmTypes.NewValidatorID(id),
-		TxHash:   txhash,
-		LogIndex: logIndex,
+		From:              from,
+		ID:                hmTypes.NewValidatorID(id),
+		DeactivationEpoch: deactivationEpoch,
+		TxHash:            txhash,
+		LogIndex:          logIndex,
 	}
 }
 
@@ -302,3 +347,338 @@ func (msg MsgValidatorExit) GetTxHash() types.HeimdallHash {
 func (msg MsgValidatorExit) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
+
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgValidatorExit) GetSideSignBytes() []byte {
+	msg.From = hmTypes.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
+//
+// validator restake
+//
+
+var _ sdk.Msg = &MsgValidatorReStake{}
+var _ hmTypes.SideTxMsg = MsgValidatorReStake{}
+
+// MsgValidatorReStake represents validator restake on mainchain
+type MsgValidatorReStake struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Total       hmTypes.Int             `json:"total"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgValidatorReStake creates new validator restake msg, total is stake after restake
+func NewMsgValidatorReStake(from hmTypes.HeimdallAddress, id uint64, total hmTypes.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgValidatorReStake {
+	return MsgValidatorReStake{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Total:       total,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgValidatorReStake) Type() string {
+	return "validator-restake"
+}
+
+func (msg MsgValidatorReStake) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorReStake) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorReStake) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorReStake) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.Total.I == nil || msg.Total.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid amount %v", msg.Total.I)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgValidatorReStake) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgValidatorReStake) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgValidatorReStake) GetSideSignBytes() []byte {
+	msg.From = hmTypes.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
+//
+// validator jailed
+//
+
+var _ sdk.Msg = &MsgValidatorJailed{}
+var _ hmTypes.SideTxMsg = MsgValidatorJailed{}
+
+// MsgValidatorJailed represents validator jailed on mainchain
+type MsgValidatorJailed struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	ExitEpoch   uint64                  `json:"exit_epoch"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgValidatorJailed creates new validator jailed msg
+func NewMsgValidatorJailed(from hmTypes.HeimdallAddress, id uint64, exitEpoch uint64, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgValidatorJailed {
+	return MsgValidatorJailed{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		ExitEpoch:   exitEpoch,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgValidatorJailed) Type() string {
+	return "validator-jailed"
+}
+
+func (msg MsgValidatorJailed) Route() string {
+	return RouterKey
+}
+
+func (msg MsgValidatorJailed) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgValidatorJailed) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgValidatorJailed) ValidateBasic() sdk.Error {
+	if msg.ID <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
+	}
+
+	if msg.From.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.From.String())
+	}
+
+	return nil
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgValidatorJailed) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgValidatorJailed) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgValidatorJailed) GetSideSignBytes() []byte {
+	msg.From = hmTypes.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
+//
+// delegator share mint
+//
+
+var _ sdk.Msg = &MsgShareMint{}
+var _ hmTypes.SideTxMsg = MsgShareMint{}
+
+// MsgShareMint represents validator shares minted to delegator on mainchain
+type MsgShareMint struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Shares      hmTypes.Int             `json:"shares"`
+	Tokens      hmTypes.Int             `json:"tokens"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgShareMint creates new share mint msg
+func NewMsgShareMint(from hmTypes.HeimdallAddress, id uint64, delegator hmTypes.HeimdallAddress, shares hmTypes.Int, tokens hmTypes.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgShareMint {
+	return MsgShareMint{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Shares:      shares,
+		Tokens:      tokens,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgShareMint) Type() string {
+	return "delegator-share-mint"
+}
+
+func (msg MsgShareMint) Route() string {
+	return RouterKey
+}
+
+func (msg MsgShareMint) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgShareMint) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgShareMint) ValidateBasic() sdk.Error {
+	return validateShareMsg(msg.From, msg.ID, msg.Delegator, msg.Shares, msg.Tokens)
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgShareMint) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgShareMint) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgShareMint) GetSideSignBytes() []byte {
+	msg.From = hmTypes.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
+//
+// delegator share burn
+//
+
+var _ sdk.Msg = &MsgShareBurn{}
+var _ hmTypes.SideTxMsg = MsgShareBurn{}
+
+// MsgShareBurn represents validator shares of delegator burned on mainchain
+type MsgShareBurn struct {
+	From        hmTypes.HeimdallAddress `json:"from"`
+	ID          hmTypes.ValidatorID     `json:"id"`
+	Delegator   hmTypes.HeimdallAddress `json:"delegator"`
+	Shares      hmTypes.Int             `json:"shares"`
+	Tokens      hmTypes.Int             `json:"tokens"`
+	TxHash      hmTypes.HeimdallHash    `json:"tx_hash"`
+	LogIndex    uint64                  `json:"log_index"`
+	BlockNumber uint64                  `json:"block_number"`
+}
+
+// NewMsgShareBurn creates new share burn msg
+func NewMsgShareBurn(from hmTypes.HeimdallAddress, id uint64, delegator hmTypes.HeimdallAddress, shares hmTypes.Int, tokens hmTypes.Int, txhash hmTypes.HeimdallHash, logIndex uint64, blockNumber uint64) MsgShareBurn {
+	return MsgShareBurn{
+		From:        from,
+		ID:          hmTypes.NewValidatorID(id),
+		Delegator:   delegator,
+		Shares:      shares,
+		Tokens:      tokens,
+		TxHash:      txhash,
+		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
+	}
+}
+
+func (msg MsgShareBurn) Type() string {
+	return "delegator-share-burn"
+}
+
+func (msg MsgShareBurn) Route() string {
+	return RouterKey
+}
+
+func (msg MsgShareBurn) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{hmTypes.HeimdallAddressToAccAddress(msg.From)}
+}
+
+func (msg MsgShareBurn) GetSignBytes() []byte {
+	b, err := cdc.MarshalJSON(msg)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(b)
+}
+
+func (msg MsgShareBurn) ValidateBasic() sdk.Error {
+	return validateShareMsg(msg.From, msg.ID, msg.Delegator, msg.Shares, msg.Tokens)
+}
+
+// GetTxHash Returns tx hash
+func (msg MsgShareBurn) GetTxHash() types.HeimdallHash {
+	return msg.TxHash
+}
+
+// GetLogIndex Returns log index
+func (msg MsgShareBurn) GetLogIndex() uint64 {
+	return msg.LogIndex
+}
+
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgShareBurn) GetSideSignBytes() []byte {
+	msg.From = hmTypes.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
+// validateShareMsg validates fields shared by share mint and burn msgs
+func validateShareMsg(from hmTypes.HeimdallAddress, id hmTypes.ValidatorID, delegator hmTypes.HeimdallAddress, shares hmTypes.Int, tokens hmTypes.Int) sdk.Error {
+	if id <= 0 {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", id)
+	}
+
+	if delegator.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid delegator %v", delegator.String())
+	}
+
+	if shares.I == nil || !shares.IsPositive() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid shares %v", shares.I)
+	}
+
+	if tokens.I == nil || tokens.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid tokens %v", tokens.I)
+	}
+
+	if from.Empty() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", from.String())
+	}
+
+	return nil
+}
@@ -8,14 +8,22 @@ const (
 
 	// DefaultProposerBonusPercent - Proposer Signer Reward Ratio
 	DefaultProposerBonusPercent = int64(10)
+
+	// DefaultValidatorSetHistory - number of blocks validator set snapshots are kept for, 0 keeps all of them
+	DefaultValidatorSetHistory = uint64(100000)
 )
 
-// ParamStoreKeyProposerBonusPercent - Store's Key for Reward amount
-var ParamStoreKeyProposerBonusPercent = []byte("proposerbonuspercent")
+var (
+	// ParamStoreKeyProposerBonusPercent - Store's Key for Reward amount
+	ParamStoreKeyProposerBonusPercent = []byte("proposerbonuspercent")
+	// ParamStoreKeyValidatorSetHistory - Store's Key for validator set snapshot retention
+	ParamStoreKeyValidatorSetHistory = []byte("validatorsethistory")
+)
 
 // ParamKeyTable type declaration for parameters
 func ParamKeyTable() subspace.KeyTable {
 	return subspace.NewKeyTable(
 		ParamStoreKeyProposerBonusPercent, DefaultProposerBonusPercent,
+		ParamStoreKeyValidatorSetHistory, DefaultValidatorSetHistory,
 	)
 }
@@ -1,10 +1,7 @@
 package types
 
 import (
-	"math/big"
-
 	"github.com/maticnetwork/heimdall/types"
-	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
 // query endpoints supported by the staking Querier
@@ -20,7 +17,11 @@ const (
 	QueryDividendAccountRoot  = "dividend-account-root"
 	QueryAccountProof         = "dividend-account-proof"
 	QueryVerifyAccountProof   = "verify-account-proof"
-	QuerySlashValidator       = "slash-validator"
+	QueryStakingSequence      = "staking-sequence"
+	QueryDelegatorDelegations = "delegator-delegations"
+	QueryValidatorDelegations = "validator-delegations"
+	QueryValidatorSetAtHeight = "validator-set-at-height"
+	QueryCheckpointValSet     = "checkpoint-validator-set"
 )
 
 // QuerySignerParams defines the params for querying by address
@@ -43,6 +44,36 @@ func NewQueryValidatorParams(validatorID types.ValidatorID) QueryValidatorParams
 	return QueryValidatorParams{ValidatorID: validatorID}
 }
 
+// QueryDelegatorParams defines the params for querying delegations of delegator
+type QueryDelegatorParams struct {
+	Delegator types.HeimdallAddress `json:"delegator"`
+}
+
+// NewQueryDelegatorParams creates a new instance of QueryDelegatorParams.
+func NewQueryDelegatorParams(delegator types.HeimdallAddress) QueryDelegatorParams {
+	return QueryDelegatorParams{Delegator: delegator}
+}
+
+// QueryHeightParams defines the params for querying validator set at height
+type QueryHeightParams struct {
+	Height int64 `json:"height"`
+}
+
+// NewQueryHeightParams creates a new instance of QueryHeightParams.
+func NewQueryHeightParams(height int64) QueryHeightParams {
+	return QueryHeightParams{Height: height}
+}
+
+// QueryCheckpointParams defines the params for querying validator set of checkpoint
+type QueryCheckpointParams struct {
+	Number uint64 `json:"number"`
+}
+
+// NewQueryCheckpointParams creates a new instance of QueryCheckpointParams.
+func NewQueryCheckpointParams(number uint64) QueryCheckpointParams {
+	return QueryCheckpointParams{Number: number}
+}
+
 // QueryDividendAccountParams defines the params for querying dividend account status.
 type QueryDividendAccountParams struct {
 	DividendAccountID types.DividendAccountID `json:"dividend_account_id"`
@@ -89,18 +120,18 @@ type QueryValidatorStatusParams struct {
 	SignerAddress []byte
 }
 
-// ValidatorSlashParams defines the params for slashing a validator
-type ValidatorSlashParams struct {
-	ValID       hmTypes.ValidatorID
-	SlashAmount *big.Int
-}
-
 // NewQueryValidatorStatusParams creates a new instance of QueryValidatorStatusParams.
 func NewQueryValidatorStatusParams(signerAddress []byte) QueryValidatorStatusParams {
 	return QueryValidatorStatusParams{SignerAddress: signerAddress}
 }
 
-// NewValidatorSlashParams creates a new instance of ValidatorSlashParams.
-func NewValidatorSlashParams(validatorID hmTypes.ValidatorID, amountToSlash *big.Int) ValidatorSlashParams {
-	return ValidatorSlashParams{ValID: validatorID, SlashAmount: amountToSlash}
+// QueryStakingSequenceParams defines the params for querying staking sequence of mainchain tx
+type QueryStakingSequenceParams struct {
+	TxHash   string `json:"tx_hash"`
+	LogIndex uint64 `json:"log_index"`
+}
+
+// NewQueryStakingSequenceParams creates a new instance of QueryStakingSequenceParams.
+func NewQueryStakingSequenceParams(txHash string, logIndex uint64) QueryStakingSequenceParams {
+	return QueryStakingSequenceParams{TxHash: txHash, LogIndex: logIndex}
 }
@@ -0,0 +1,26 @@
+package types
+
+import (
+	"fmt"
+
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+// ValidatorSetSnapshot validator set as it was after block at given height
+type ValidatorSetSnapshot struct {
+	Height       int64                `json:"height" yaml:"height"`
+	ValidatorSet hmTypes.ValidatorSet `json:"validator_set" yaml:"validator_set"`
+}
+
+// NewValidatorSetSnapshot creates new validator set snapshot
+func NewValidatorSetSnapshot(height int64, validatorSet hmTypes.ValidatorSet) ValidatorSetSnapshot {
+	return ValidatorSetSnapshot{
+		Height:       height,
+		ValidatorSet: validatorSet,
+	}
+}
+
+// String returns human readable validator set snapshot
+func (s ValidatorSetSnapshot) String() string {
+	return fmt.Sprintf("ValidatorSetSnapshot{%v %v}", s.Height, s.ValidatorSet)
+}
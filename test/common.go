@@ -3,9 +3,9 @@ package test
 import (
 	"bytes"
 	"encoding/hex"
+	"fmt"
 	"math/big"
 	"math/rand"
-	"os"
 	"testing"
 	"time"
 
@@ -23,8 +23,8 @@ import (
 	borTypes "github.com/maticnetwork/heimdall/bor/types"
 	"github.com/maticnetwork/heimdall/checkpoint"
 	checkpointTypes "github.com/maticnetwork/heimdall/checkpoint/types"
+	clerkTypes "github.com/maticnetwork/heimdall/clerk/types"
 	"github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/params"
 	paramsTypes "github.com/maticnetwork/heimdall/params/types"
 	"github.com/maticnetwork/heimdall/staking"
@@ -43,17 +43,54 @@ func MakeTestCodec() *codec.Codec {
 	// custom types
 	borTypes.RegisterCodec(cdc)
 	checkpointTypes.RegisterCodec(cdc)
+	clerkTypes.RegisterCodec(cdc)
 	stakingTypes.RegisterCodec(cdc)
 
 	cdc.Seal()
 	return cdc
 }
 
+// ModuleCommunicator is a test module communicator which reads ack count from
+// checkpoint keeper and keeps account balances in memory
+type ModuleCommunicator struct {
+	checkpoint.Keeper
+	coins map[string]types.Coins
+}
+
+// NewModuleCommunicator returns test module communicator backed by checkpoint keeper
+func NewModuleCommunicator(checkpointKeeper checkpoint.Keeper) *ModuleCommunicator {
+	return &ModuleCommunicator{
+		Keeper: checkpointKeeper,
+		coins:  make(map[string]types.Coins),
+	}
+}
+
+// SetCoins sets coins
+func (m *ModuleCommunicator) SetCoins(ctx sdk.Context, addr types.HeimdallAddress, amt types.Coins) sdk.Error {
+	m.coins[addr.String()] = amt
+	return nil
+}
+
+// GetCoins gets coins
+func (m *ModuleCommunicator) GetCoins(ctx sdk.Context, addr types.HeimdallAddress) types.Coins {
+	return m.coins[addr.String()]
+}
+
+// SendCoins transfers coins
+func (m *ModuleCommunicator) SendCoins(ctx sdk.Context, from types.HeimdallAddress, to types.HeimdallAddress, amt types.Coins) sdk.Error {
+	remaining, hasNeg := m.GetCoins(ctx, from).SafeSub(amt)
+	if hasNeg {
+		return sdk.ErrInsufficientCoins(fmt.Sprintf("insufficient account funds; %s < %s", m.GetCoins(ctx, from), amt))
+	}
+
+	m.coins[from.String()] = remaining
+	m.coins[to.String()] = m.GetCoins(ctx, to).Add(amt)
+	return nil
+}
+
 // init for test cases
 func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, staking.Keeper, checkpoint.Keeper) {
 	//t.Parallel()
-	helper.InitHeimdallConfig(os.ExpandEnv("$HOME/.heimdalld"))
-
 	db := dbm.NewMemDB()
 	ms := store.NewCommitMultiStore(db)
 
@@ -79,29 +116,32 @@ func CreateTestInput(t *testing.T, isCheckTx bool) (sdk.Context, staking.Keeper,
 	//pulp := MakeTestPulp()
 	paramsKeeper := params.NewKeeper(cdc, keyParams, tKeyParams, common.DefaultCodespace)
 
-	dummyStakingKeeper := staking.Keeper{}
+	// staking keeper reads ack count from checkpoint keeper created after it
+	moduleCommunicator := NewModuleCommunicator(checkpoint.Keeper{})
 
-	checkpointKeeper := checkpoint.NewKeeper(
+	stakingKeeper := staking.NewKeeper(
 		cdc,
-		keyCheckpoint,
-		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
+		keyStaking,
+		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
 		common.DefaultCodespace,
-		dummyStakingKeeper,
+		moduleCommunicator,
 	)
 
-	stakingKeeper := staking.NewKeeper(
+	checkpointKeeper := checkpoint.NewKeeper(
 		cdc,
-		keyStaking,
-		paramsKeeper.Subspace(stakingTypes.DefaultParamspace),
+		keyCheckpoint,
+		paramsKeeper.Subspace(checkpointTypes.DefaultParamspace),
 		common.DefaultCodespace,
-		checkpointKeeper,
+		stakingKeeper,
+		nil,
 	)
+	moduleCommunicator.Keeper = checkpointKeeper
+
 	return ctx, stakingKeeper, checkpointKeeper
 }
 
 // create random header block
 func GenRandCheckpointHeader(start int, headerSize int) (headerBlock types.CheckpointBlockHeader, err error) {
-	start = start
 	end := start + headerSize
 	roothash, err := checkpointTypes.GetHeaders(uint64(start), uint64(end))
 	if err != nil {
@@ -5,6 +5,9 @@ const (
 	FlagValidatorID     = "validator-id"
 	FlagTxHash          = "tx-hash"
 	FlagLogIndex        = "log-index"
+	FlagSignerAddress   = "signer"
+	FlagFeeAmount       = "fee-amount"
+	FlagBlockNumber     = "block-number"
 	FlagTo              = "to"
 	FlagAmount          = "amount"
 )
@@ -60,6 +60,10 @@ func GetSequence(cdc *codec.Codec) *cobra.Command {
 
 			res, _, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", types.QuerierRoute, t), queryParams)
 			if err != nil {
+				return err
+			}
+
+			if len(res) == 0 {
 				fmt.Println("No topup exists")
 				return nil
 			}
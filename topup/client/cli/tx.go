@@ -61,12 +61,25 @@ func TopupTxCmd(cdc *codec.Codec) *cobra.Command {
 				return fmt.Errorf("transaction hash has to be supplied")
 			}
 
+			signer := types.HexToHeimdallAddress(viper.GetString(FlagSignerAddress))
+			if signer.Empty() {
+				return fmt.Errorf("signer address has to be supplied")
+			}
+
+			fee, ok := big.NewInt(0).SetString(viper.GetString(FlagFeeAmount), 10)
+			if !ok {
+				return errors.New("Invalid fee amount")
+			}
+
 			// build and sign the transaction, then broadcast to Tendermint
 			msg := topupTypes.NewMsgTopup(
 				proposer,
 				uint64(validatorID),
+				signer,
+				types.NewIntFromBigInt(fee),
 				types.HexToHeimdallHash(txhash),
 				uint64(viper.GetInt64(FlagLogIndex)),
+				uint64(viper.GetInt64(FlagBlockNumber)),
 			)
 
 			// broadcast msg with cli
@@ -77,9 +90,15 @@ func TopupTxCmd(cdc *codec.Codec) *cobra.Command {
 	cmd.Flags().Int(FlagValidatorID, 0, "--validator-id=<validator ID here>")
 	cmd.Flags().String(FlagTxHash, "", "--tx-hash=<transaction-hash>")
 	cmd.Flags().String(FlagLogIndex, "", "--log-index=<log-index>")
+	cmd.Flags().String(FlagSignerAddress, "", "--signer=<signer-address>")
+	cmd.Flags().String(FlagFeeAmount, "", "--fee-amount=<fee-amount>")
+	cmd.Flags().String(FlagBlockNumber, "", "--block-number=<block-number>")
 	cmd.MarkFlagRequired(FlagValidatorID)
 	cmd.MarkFlagRequired(FlagTxHash)
 	cmd.MarkFlagRequired(FlagLogIndex)
+	cmd.MarkFlagRequired(FlagSignerAddress)
+	cmd.MarkFlagRequired(FlagFeeAmount)
+	cmd.MarkFlagRequired(FlagBlockNumber)
 	return cmd
 }
 
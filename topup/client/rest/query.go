@@ -0,0 +1,64 @@
+package rest
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client/context"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	"github.com/gorilla/mux"
+
+	topupTypes "github.com/maticnetwork/heimdall/topup/types"
+	hmRest "github.com/maticnetwork/heimdall/types/rest"
+)
+
+func registerQueryRoutes(cliCtx context.CLIContext, r *mux.Router) {
+	r.HandleFunc(
+		"/topup/sequence",
+		sequenceHandlerFn(cliCtx),
+	).Methods("GET")
+}
+
+// Returns topup sequence if topup tx (tx hash and log index) is already processed
+func sequenceHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		cliCtx, ok := rest.ParseQueryHeightOrReturnBadRequest(w, cliCtx, r)
+		if !ok {
+			return
+		}
+
+		params := r.URL.Query()
+		logIndex, ok := rest.ParseUint64OrReturnBadRequest(w, params.Get("log_index"))
+		if !ok {
+			return
+		}
+
+		txHash := params.Get("tx_hash")
+		if txHash == "" {
+			hmRest.WriteErrorResponse(w, http.StatusBadRequest, "tx_hash is required")
+			return
+		}
+
+		// get query params
+		queryParams, err := cliCtx.Codec.MarshalJSON(topupTypes.NewQuerySequenceParams(txHash, logIndex))
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		res, height, err := cliCtx.QueryWithData(fmt.Sprintf("custom/%s/%s", topupTypes.QuerierRoute, topupTypes.QuerySequence), queryParams)
+		if err != nil {
+			hmRest.WriteErrorResponse(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+
+		// tx is not processed yet
+		if ok := hmRest.ReturnNotFoundIfNoContent(w, res, "No topup sequence found"); !ok {
+			return
+		}
+
+		// return result
+		cliCtx = cliCtx.WithHeight(height)
+		rest.PostProcessResponse(w, cliCtx, res)
+	}
+}
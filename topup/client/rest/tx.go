@@ -19,6 +19,8 @@ import (
 func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 	r.HandleFunc("/topup/fee", TopupHandlerFn(cliCtx)).Methods("POST")
 	r.HandleFunc("/topup/withdraw", WithdrawFeeHandlerFn(cliCtx)).Methods("POST")
+
+	registerQueryRoutes(cliCtx, r)
 }
 
 //
@@ -29,9 +31,12 @@ func RegisterRoutes(cliCtx context.CLIContext, r *mux.Router) {
 type TopupReq struct {
 	BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
 
-	ID       uint64 `json:"id" yaml:"id"`
-	TxHash   string `json:"tx_hash" yaml:"tx_hash"`
-	LogIndex uint64 `json:"log_index" yaml:"log_index"`
+	ID          uint64    `json:"id" yaml:"id"`
+	Signer      string    `json:"signer" yaml:"signer"`
+	Fee         types.Int `json:"fee" yaml:"fee"`
+	TxHash      string    `json:"tx_hash" yaml:"tx_hash"`
+	LogIndex    uint64    `json:"log_index" yaml:"log_index"`
+	BlockNumber uint64    `json:"block_number" yaml:"block_number"`
 }
 
 // TopupHandlerFn - http request handler to topup coins to a address.
@@ -54,8 +59,11 @@ func TopupHandlerFn(cliCtx context.CLIContext) http.HandlerFunc {
 		msg := topupTypes.NewMsgTopup(
 			fromAddr,
 			req.ID,
+			types.HexToHeimdallAddress(req.Signer),
+			req.Fee,
 			types.HexToHeimdallHash(req.TxHash),
 			req.LogIndex,
+			req.BlockNumber,
 		)
 		restClient.WriteGenerateStdTxResponse(w, cliCtx, req.BaseReq, []sdk.Msg{msg})
 	}
@@ -8,19 +8,19 @@ import (
 	"github.com/maticnetwork/heimdall/auth"
 	authTypes "github.com/maticnetwork/heimdall/auth/types"
 	hmCommon "github.com/maticnetwork/heimdall/common"
-	"github.com/maticnetwork/heimdall/helper"
 	"github.com/maticnetwork/heimdall/topup/types"
 	hmTypes "github.com/maticnetwork/heimdall/types"
 )
 
-// NewHandler returns a handler for "topup" type messages.
-func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
+// NewHandler returns a handler for "topup" type messages. MsgTopup reaches it through
+// sidechannel, once validators voted for it.
+func NewHandler(k Keeper) sdk.Handler {
 	return func(ctx sdk.Context, msg sdk.Msg) sdk.Result {
 		ctx = ctx.WithEventManager(sdk.NewEventManager())
 
 		switch msg := msg.(type) {
 		case types.MsgTopup:
-			return handleMsgTopup(ctx, k, msg, contractCaller)
+			return handleMsgTopup(ctx, k, msg)
 		case types.MsgWithdrawFee:
 			return handleMsgWithdrawFee(ctx, k, msg)
 		default:
@@ -31,31 +31,13 @@ func NewHandler(k Keeper, contractCaller helper.IContractCaller) sdk.Handler {
 }
 
 // Handle MsgMintFeeToken
-func handleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractCaller helper.IContractCaller) sdk.Result {
+func handleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup) sdk.Result {
 	if !k.bk.GetSendEnabled(ctx) {
 		return types.ErrSendDisabled(k.Codespace()).Result()
 	}
 
-	// get main tx receipt
-	receipt, err := contractCaller.GetConfirmedTxReceipt(ctx.BlockTime(), msg.TxHash.EthHash())
-	if err != nil || receipt == nil {
-		return hmCommon.ErrWaitForConfirmation(k.Codespace()).Result()
-	}
-
-	// get event log for topup
-	eventLog, err := contractCaller.DecodeValidatorTopupFeesEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
-	if err != nil || eventLog == nil {
-		k.Logger(ctx).Error("Error fetching log from txhash")
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Unable to fetch logs for txHash").Result()
-	}
-
-	if eventLog.ValidatorId.Uint64() != msg.ID.Uint64() {
-		k.Logger(ctx).Error("ID in message doesn't match id in logs", "MsgID", msg.ID, "IdFromTx", eventLog.ValidatorId)
-		return hmCommon.ErrInvalidMsg(k.Codespace(), "Invalid txhash and id don't match. Id from tx hash is %v", eventLog.ValidatorId.Uint64()).Result()
-	}
-
 	// use event log signer
-	signer := hmTypes.BytesToHeimdallAddress(eventLog.Signer.Bytes())
+	signer := msg.Signer
 	// if validator exists use siger from local state
 	validator, found := k.sk.GetValidatorFromValID(ctx, msg.ID)
 	if found {
@@ -63,11 +45,11 @@ func handleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractCalle
 	}
 
 	// create topup amount
-	topupAmount := hmTypes.Coins{hmTypes.Coin{Denom: authTypes.FeeToken, Amount: hmTypes.NewIntFromBigInt(eventLog.Fee)}}
+	topupAmount := hmTypes.Coins{hmTypes.Coin{Denom: authTypes.FeeToken, Amount: msg.Fee}}
 
 	// sequence id
 
-	sequence := new(big.Int).Mul(receipt.BlockNumber, big.NewInt(hmTypes.DefaultLogIndexUnit))
+	sequence := new(big.Int).Mul(new(big.Int).SetUint64(msg.BlockNumber), big.NewInt(hmTypes.DefaultLogIndexUnit))
 	sequence.Add(sequence, new(big.Int).SetUint64(msg.LogIndex))
 
 	// check if incoming tx already exists
@@ -95,7 +77,7 @@ func handleMsgTopup(ctx sdk.Context, k Keeper, msg types.MsgTopup, contractCalle
 			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
 			sdk.NewAttribute(types.AttributeKeyValidatorID, msg.ID.String()),
 			sdk.NewAttribute(types.AttributeKeyValidatorSigner, signer.String()),
-			sdk.NewAttribute(types.AttributeKeyTopupAmount, eventLog.Fee.String()),
+			sdk.NewAttribute(types.AttributeKeyTopupAmount, msg.Fee.String()),
 		),
 	})
 
@@ -108,7 +108,7 @@ func (AppModule) Route() string {
 
 // NewHandler returns an sdk.Handler for the module.
 func (am AppModule) NewHandler() sdk.Handler {
-	return NewHandler(am.keeper, am.contractCaller)
+	return NewHandler(am.keeper)
 }
 
 // QuerierRoute returns the auth module's querier route name.
@@ -0,0 +1,51 @@
+package topup
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/maticnetwork/heimdall/helper"
+	"github.com/maticnetwork/heimdall/topup/types"
+	hmTypes "github.com/maticnetwork/heimdall/types"
+)
+
+var sideTxLogger = helper.Logger.With("module", "topup/side")
+
+// NewSideTxHandler returns handler which validates topup msgs against mainchain.
+// It is run by validators outside of consensus (see sidechannel).
+func NewSideTxHandler(contractCaller helper.IContractCaller) hmTypes.SideTxHandler {
+	return func(msg sdk.Msg) (bool, error) {
+		switch msg := msg.(type) {
+		case types.MsgTopup:
+			return SideHandleMsgTopup(msg, contractCaller)
+		default:
+			return false, nil
+		}
+	}
+}
+
+// SideHandleMsgTopup validates topup against topup fees event
+func SideHandleMsgTopup(msg types.MsgTopup, contractCaller helper.IContractCaller) (bool, error) {
+	// get main tx receipt, retried until confirmed
+	receipt, err := contractCaller.GetConfirmedTxReceipt(msg.TxHash.EthHash())
+	if err != nil {
+		return false, err
+	}
+
+	eventLog, err := contractCaller.DecodeValidatorTopupFeesEvent(helper.GetStakingInfoAddress(), receipt, msg.LogIndex)
+	if err != nil || eventLog == nil {
+		sideTxLogger.Error("Unable to fetch topup log for txHash", "txHash", msg.TxHash, "error", err)
+		return false, nil
+	}
+
+	if receipt.BlockNumber == nil || receipt.BlockNumber.Uint64() != msg.BlockNumber ||
+		eventLog.ValidatorId.Uint64() != msg.ID.Uint64() ||
+		!bytes.Equal(eventLog.Signer.Bytes(), msg.Signer.Bytes()) ||
+		eventLog.Fee.Cmp(msg.Fee.BigInt()) != 0 {
+		sideTxLogger.Error("Topup msg doesn't match log", "msg", msg, "validatorIdFromTx", eventLog.ValidatorId, "signer", eventLog.Signer.Hex(), "fee", eventLog.Fee, "blockNumber", receipt.BlockNumber)
+		return false, nil
+	}
+
+	return true, nil
+}
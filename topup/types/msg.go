@@ -17,24 +17,34 @@ import (
 type MsgTopup struct {
 	FromAddress types.HeimdallAddress `json:"from_address"`
 	ID          types.ValidatorID     `json:"id"`
+	Signer      types.HeimdallAddress `json:"signer"`
+	Fee         types.Int             `json:"fee"`
 	TxHash      types.HeimdallHash    `json:"tx_hash"`
 	LogIndex    uint64                `json:"log_index"`
+	BlockNumber uint64                `json:"block_number"`
 }
 
 var _ sdk.Msg = MsgTopup{}
+var _ types.SideTxMsg = MsgTopup{}
 
 // NewMsgTopup - construct arbitrary multi-in, multi-out send msg.
 func NewMsgTopup(
 	fromAddr types.HeimdallAddress,
 	id uint64,
+	signer types.HeimdallAddress,
+	fee types.Int,
 	txhash types.HeimdallHash,
 	logIndex uint64,
+	blockNumber uint64,
 ) MsgTopup {
 	return MsgTopup{
 		FromAddress: fromAddr,
 		ID:          types.NewValidatorID(id),
+		Signer:      signer,
+		Fee:         fee,
 		TxHash:      txhash,
 		LogIndex:    logIndex,
+		BlockNumber: blockNumber,
 	}
 }
 
@@ -58,6 +68,10 @@ func (msg MsgTopup) ValidateBasic() sdk.Error {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid validator ID %v", msg.ID)
 	}
 
+	if msg.Fee.I == nil || msg.Fee.IsNegative() {
+		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid fee %v", msg.Fee.I)
+	}
+
 	if msg.FromAddress.Empty() {
 		return hmCommon.ErrInvalidMsg(hmCommon.DefaultCodespace, "Invalid proposer %v", msg.FromAddress.String())
 	}
@@ -85,6 +99,12 @@ func (msg MsgTopup) GetLogIndex() uint64 {
 	return msg.LogIndex
 }
 
+// GetSideSignBytes returns sign bytes of msg without sender
+func (msg MsgTopup) GetSideSignBytes() []byte {
+	msg.FromAddress = types.ZeroHeimdallAddress
+	return msg.GetSignBytes()
+}
+
 //
 // Fee token withdrawal
 //
@@ -0,0 +1,29 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SideTxMsg msg whose validity depends on mainchain. It is validated by each validator
+// outside of consensus, and applied to state once +2/3 voting power voted for it.
+type SideTxMsg interface {
+	sdk.Msg
+
+	GetTxHash() HeimdallHash
+	GetLogIndex() uint64
+
+	// GetSideSignBytes returns sign bytes of msg content without sender, so the same event
+	// relayed by different validators is the same side tx
+	GetSideSignBytes() []byte
+}
+
+// ProposerSideTxMsg side tx msg which only current proposer of validator set can submit (eg. checkpoint)
+type ProposerSideTxMsg interface {
+	SideTxMsg
+
+	GetProposer() HeimdallAddress
+}
+
+// SideTxHandler validates side tx msg against mainchain. Error means msg can't be validated
+// yet (eg. mainchain tx is not confirmed) and validation should be retried later.
+type SideTxHandler func(msg sdk.Msg) (bool, error)
@@ -22,6 +22,9 @@ type Validator struct {
 	LastUpdated string          `json:"last_updated"`
 
 	ProposerPriority int64 `json:"accum"`
+
+	// appended last, so validators stored before it was added decode unchanged
+	Jailed bool `json:"jailed"`
 }
 
 func NewValidator(id ValidatorID, startEpoch uint64, endEpoch uint64, power int64, pubKey PubKey, signer HeimdallAddress) *Validator {
@@ -48,8 +51,8 @@ func (v *Validator) IsCurrentValidator(ackCount uint64) bool {
 	// current epoch will be ack count + 1
 	currentEpoch := ackCount + 1
 
-	// validator hasnt initialised unstake
-	if v.StartEpoch <= currentEpoch && (v.EndEpoch == 0 || v.EndEpoch >= currentEpoch) && v.VotingPower > 0 {
+	// validator hasnt initialised unstake and is not jailed
+	if v.StartEpoch <= currentEpoch && (v.EndEpoch == 0 || v.EndEpoch >= currentEpoch) && v.VotingPower > 0 && !v.Jailed {
 		return true
 	}
 
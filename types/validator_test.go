@@ -0,0 +1,59 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/stretchr/testify/require"
+)
+
+// legacyValidator is validator layout stored before jailed flag was added
+type legacyValidator struct {
+	ID          ValidatorID     `json:"ID"`
+	StartEpoch  uint64          `json:"startEpoch"`
+	EndEpoch    uint64          `json:"endEpoch"`
+	VotingPower int64           `json:"power"`
+	PubKey      PubKey          `json:"pubKey"`
+	Signer      HeimdallAddress `json:"signer"`
+	LastUpdated string          `json:"last_updated"`
+
+	ProposerPriority int64 `json:"accum"`
+}
+
+func TestUnmarshallLegacyValidator(t *testing.T) {
+	cdc := codec.New()
+	legacy := legacyValidator{
+		ID:               1,
+		StartEpoch:       2,
+		EndEpoch:         3,
+		VotingPower:      10,
+		PubKey:           StringToPubkey("04b12d8b2f6e3d45a7ace12c4b2158f79b95e4c28ebe5ad54c439be9431d7fc9dc1164210bf6a5c3b8523528b931e772c86a307e8cff4b725e6b4a77d21417bf19"),
+		Signer:           HexToHeimdallAddress("6C468CF8C9879006E22EC4029696E005C2319C9D"),
+		LastUpdated:      "100",
+		ProposerPriority: -5,
+	}
+
+	bz, err := cdc.MarshalBinaryBare(legacy)
+	require.NoError(t, err)
+
+	validator, err := UnmarshallValidator(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, Validator{
+		ID:               legacy.ID,
+		StartEpoch:       legacy.StartEpoch,
+		EndEpoch:         legacy.EndEpoch,
+		VotingPower:      legacy.VotingPower,
+		PubKey:           legacy.PubKey,
+		Signer:           legacy.Signer,
+		LastUpdated:      legacy.LastUpdated,
+		ProposerPriority: legacy.ProposerPriority,
+	}, validator)
+
+	// jailed flag round trips
+	validator.Jailed = true
+	bz, err = MarshallValidator(cdc, validator)
+	require.NoError(t, err)
+	decoded, err := UnmarshallValidator(cdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, validator, decoded)
+}